@@ -6,4 +6,10 @@ package acctest
 // Exports for use in tests only.
 var (
 	CloseVCRRecorder = closeVCRRecorder
+	VCRNormalizeURL  = vcrNormalizeURL
+	VCRScrub         = vcrScrub
+
+	VCRAccountID = vcrAccountID
 )
+
+type VCRSTSTransport = vcrSTSTransport
@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// MockAccountID is the account ID of the caller identity returned by a MockServer.
+	MockAccountID = "123456789012"
+
+	// MockRegion is the AWS Region of clients configured by a MockServer.
+	MockRegion = names.USWest2RegionID
+
+	mockAccessKey = "AKIAMOCKACCESSKEYID"
+	mockSecretKey = "mock-secret-access-key"
+)
+
+// mockRESTXMLServices are the services, other than S3, that use the restXml protocol.
+var mockRESTXMLServices = []string{
+	names.CloudFront,
+	names.Route53,
+	names.S3Control,
+}
+
+// MockProtocol is the AWS protocol of a request received by a MockServer.
+type MockProtocol string
+
+const (
+	// MockProtocolJSON is the awsJson1_0 and awsJson1_1 protocols, where the operation is named in the X-Amz-Target header.
+	MockProtocolJSON MockProtocol = "json"
+	// MockProtocolQuery is the awsQuery and ec2Query protocols, where the operation is named in the Action form field.
+	MockProtocolQuery MockProtocol = "query"
+	// MockProtocolREST is the restJson1 and restXml protocols, where the operation is identified by HTTP method and path.
+	MockProtocolREST MockProtocol = "rest"
+)
+
+// MockRequest is a request received by a MockServer.
+type MockRequest struct {
+	*http.Request
+
+	// Service is the provider package name of the service, e.g. "sqs".
+	Service string
+	// Protocol is the AWS protocol of the request.
+	Protocol MockProtocol
+	// Operation is the API operation name for the JSON and query protocols,
+	// or the handler's "METHOD /path" pattern for REST protocols.
+	Operation string
+	// Payload is the request body.
+	Payload []byte
+	// PathParameters are the values of the {Label} segments in the handler's REST pattern.
+	PathParameters map[string]string
+
+	t *testing.T
+}
+
+// DecodeJSONBody unmarshals the request's JSON body into v.
+func (r *MockRequest) DecodeJSONBody(v any) error {
+	return json.Unmarshal(r.Payload, v)
+}
+
+// FormValues returns the parameters of a query protocol request.
+func (r *MockRequest) FormValues() url.Values {
+	values, _ := url.ParseQuery(string(r.Payload))
+
+	return values
+}
+
+// MockHandler writes the response to a request received by a MockServer.
+type MockHandler func(w http.ResponseWriter, r *MockRequest)
+
+type mockRoute struct {
+	service   string
+	operation string
+	handler   MockHandler
+}
+
+// MockServer is an in-process HTTP server that stands in for AWS service endpoints.
+// It allows resource CRUD, finder, waiter, not-found and retry handling to be unit tested
+// with programmable responses and without AWS credentials.
+//
+// Each service is served at its own path, e.g. http://127.0.0.1:port/sqs, and all service endpoints
+// of a client returned by Client are set to the server so no requests are sent to AWS.
+// STS GetCallerIdentity is handled by default.
+type MockServer struct {
+	t         *testing.T
+	server    *httptest.Server
+	mu        sync.Mutex
+	routes    []mockRoute
+	requests  []*MockRequest
+	requestID atomic.Int64
+}
+
+// NewMockServer starts a MockServer that is closed when the test completes.
+func NewMockServer(t *testing.T) *MockServer {
+	t.Helper()
+
+	s := &MockServer{
+		t: t,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.server.Close)
+
+	s.Handle(names.STS, "GetCallerIdentity", MockQueryResponse(fmt.Sprintf(`<Arn>arn:%[1]s:iam::%[2]s:user/mock</Arn><UserId>AIDAMOCKUSERID</UserId><Account>%[2]s</Account>`, names.StandardPartitionID, MockAccountID)))
+
+	return s
+}
+
+// URL returns the base URL of the server.
+func (s *MockServer) URL() string {
+	return s.server.URL
+}
+
+// Endpoint returns the URL at which the specified service is served.
+func (s *MockServer) Endpoint(service string) string {
+	return s.server.URL + "/" + service
+}
+
+// Handle registers the handler for the specified service and operation.
+// For the JSON and query protocols, operation is the API operation name, e.g. "CreateQueue".
+// For REST protocols, operation is a "METHOD /path" pattern relative to the service endpoint, e.g.
+// "GET /2015-03-31/functions/{FunctionName}". {Label} matches a single path segment and {Label+} the remainder of the path.
+// A handler registered later for the same service and operation replaces the earlier one.
+func (s *MockServer) Handle(service, operation string, handler MockHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes = append(s.routes, mockRoute{
+		service:   service,
+		operation: operation,
+		handler:   handler,
+	})
+}
+
+// Requests returns the requests received for the specified service and operation, in the order received.
+func (s *MockServer) Requests(service, operation string) []*MockRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []*MockRequest
+	for _, r := range s.requests {
+		if r.Service == service && r.Operation == operation {
+			requests = append(requests, r)
+		}
+	}
+
+	return requests
+}
+
+// ProviderConfig returns the raw provider configuration that sends all AWS API requests to the server.
+// S3 requests use path-style addressing as bucket names can't be resolved as subdomains of the server's address.
+func (s *MockServer) ProviderConfig() map[string]any {
+	endpoints := make(map[string]any)
+	for _, endpoint := range names.Endpoints() {
+		endpoints[endpoint.ProviderPackage] = s.Endpoint(endpoint.ProviderPackage)
+	}
+
+	return map[string]any{
+		"access_key":                  mockAccessKey,
+		"endpoints":                   []any{endpoints},
+		"max_retries":                 1,
+		"region":                      MockRegion,
+		"s3_use_path_style":           true,
+		"secret_key":                  mockSecretKey,
+		"skip_credentials_validation": true,
+		"skip_metadata_api_check":     "true",
+	}
+}
+
+// Client returns a new, configured AWS client that sends all AWS API requests to the server.
+// The provider configuration can be modified by the optional functions before the client is configured.
+func (s *MockServer) Client(ctx context.Context, t *testing.T, optFns ...func(map[string]any)) *conns.AWSClient {
+	t.Helper()
+
+	config := s.ProviderConfig()
+	for _, optFn := range optFns {
+		optFn(config)
+	}
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	return p.Meta().(*conns.AWSClient)
+}
+
+func (s *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	service, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	request := &MockRequest{
+		Request: r,
+		Service: service,
+		Payload: body,
+		t:       s.t,
+	}
+
+	switch {
+	case r.Header.Get("X-Amz-Target") != "":
+		request.Protocol = MockProtocolJSON
+		target := r.Header.Get("X-Amz-Target")
+		request.Operation = target[strings.LastIndex(target, ".")+1:]
+	case strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") && request.FormValues().Has("Action"):
+		request.Protocol = MockProtocolQuery
+		request.Operation = request.FormValues().Get("Action")
+	default:
+		request.Protocol = MockProtocolREST
+	}
+
+	s.mu.Lock()
+	var handler MockHandler
+	// Later registrations take precedence.
+	for i := len(s.routes) - 1; i >= 0; i-- {
+		route := s.routes[i]
+
+		if route.service != service {
+			continue
+		}
+
+		if request.Protocol == MockProtocolREST {
+			if parameters, ok := mockMatchREST(route.operation, r.Method, "/"+path); ok {
+				request.Operation = route.operation
+				request.PathParameters = parameters
+				handler = route.handler
+				break
+			}
+		} else if route.operation == request.Operation {
+			handler = route.handler
+			break
+		}
+	}
+	if request.Protocol == MockProtocolREST && handler == nil {
+		request.Operation = r.Method + " /" + path
+	}
+	s.requests = append(s.requests, request)
+	s.mu.Unlock()
+
+	w.Header().Set("X-Amzn-Requestid", fmt.Sprintf("mock-request-%d", s.requestID.Add(1)))
+
+	if handler == nil {
+		s.t.Errorf("mock server: no handler for %s %s request (%s %s)", service, request.Operation, r.Method, r.URL)
+		MockErrorResponse(http.StatusBadRequest, "MockNotImplemented", fmt.Sprintf("no handler for %s %s", service, request.Operation))(w, request)
+		return
+	}
+
+	handler(w, request)
+}
+
+// mockMatchREST returns whether the specified request method and path match a "METHOD /path" pattern,
+// along with the values of any path parameters.
+func mockMatchREST(pattern, method, path string) (map[string]string, bool) {
+	patternMethod, patternPath, ok := strings.Cut(pattern, " ")
+	if !ok || patternMethod != method {
+		return nil, false
+	}
+
+	patternSegments := strings.Split(strings.Trim(patternPath, "/"), "/")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	parameters := make(map[string]string)
+
+	for i, patternSegment := range patternSegments {
+		if label, ok := strings.CutPrefix(patternSegment, "{"); ok {
+			label = strings.TrimSuffix(label, "}")
+
+			if label, ok := strings.CutSuffix(label, "+"); ok {
+				if i >= len(segments) {
+					return nil, false
+				}
+				parameters[label] = mockUnescapePath(strings.Join(segments[i:], "/"))
+				return parameters, true
+			}
+
+			if i >= len(segments) || segments[i] == "" {
+				return nil, false
+			}
+			parameters[label] = mockUnescapePath(segments[i])
+			continue
+		}
+
+		if i >= len(segments) || segments[i] != patternSegment {
+			return nil, false
+		}
+	}
+
+	if len(segments) != len(patternSegments) {
+		return nil, false
+	}
+
+	return parameters, true
+}
+
+func mockUnescapePath(s string) string {
+	if v, err := url.PathUnescape(s); err == nil {
+		return v
+	}
+
+	return s
+}
+
+// MockJSONResponse returns a handler that responds with HTTP 200 and v marshaled as JSON.
+// A string or []byte value is written as is.
+func MockJSONResponse(v any) MockHandler {
+	return func(w http.ResponseWriter, r *MockRequest) {
+		var body []byte
+		switch v := v.(type) {
+		case string:
+			body = []byte(v)
+		case []byte:
+			body = v
+		default:
+			var err error
+			if body, err = json.Marshal(v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", mockJSONContentType(r))
+		w.WriteHeader(http.StatusOK)
+		w.Write(body) //nolint:errcheck // Best effort.
+	}
+}
+
+// MockQueryResponse returns a handler that responds with HTTP 200 and an awsQuery protocol response
+// whose <OperationResult> element contains the specified XML.
+func MockQueryResponse(result string) MockHandler {
+	return func(w http.ResponseWriter, r *MockRequest) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult><ResponseMetadata><RequestId>%[3]s</RequestId></ResponseMetadata></%[1]sResponse>`, r.Operation, result, w.Header().Get("X-Amzn-Requestid"))
+	}
+}
+
+// MockRawResponse returns a handler that responds with the specified status code, content type and body.
+func MockRawResponse(statusCode int, contentType, body string) MockHandler {
+	return func(w http.ResponseWriter, r *MockRequest) {
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		w.WriteHeader(statusCode)
+		io.WriteString(w, body) //nolint:errcheck // Best effort.
+	}
+}
+
+// MockErrorResponse returns a handler that responds with an AWS API error in the request's protocol.
+func MockErrorResponse(statusCode int, code, message string) MockHandler {
+	return func(w http.ResponseWriter, r *MockRequest) {
+		requestID := w.Header().Get("X-Amzn-Requestid")
+
+		switch {
+		case r.Protocol == MockProtocolQuery && r.Service == names.EC2:
+			w.Header().Set("Content-Type", "text/xml")
+			w.WriteHeader(statusCode)
+			fmt.Fprintf(w, `<Response><Errors><Error><Code>%[1]s</Code><Message>%[2]s</Message></Error></Errors><RequestID>%[3]s</RequestID></Response>`, code, mockEscapeXML(message), requestID)
+		case r.Protocol == MockProtocolREST && r.Service == names.S3:
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(statusCode)
+			fmt.Fprintf(w, `<Error><Code>%[1]s</Code><Message>%[2]s</Message><RequestId>%[3]s</RequestId></Error>`, code, mockEscapeXML(message), requestID)
+		case r.Protocol == MockProtocolQuery || (r.Protocol == MockProtocolREST && slices.Contains(mockRESTXMLServices, r.Service)):
+			w.Header().Set("Content-Type", "text/xml")
+			w.WriteHeader(statusCode)
+			fmt.Fprintf(w, `<ErrorResponse><Error><Type>Sender</Type><Code>%[1]s</Code><Message>%[2]s</Message></Error><RequestId>%[3]s</RequestId></ErrorResponse>`, code, mockEscapeXML(message), requestID)
+		default:
+			body, _ := json.Marshal(map[string]string{
+				"__type":  code,
+				"message": message,
+			})
+
+			w.Header().Set("Content-Type", mockJSONContentType(r))
+			w.Header().Set("X-Amzn-Errortype", code)
+			w.WriteHeader(statusCode)
+			w.Write(body) //nolint:errcheck // Best effort.
+		}
+	}
+}
+
+// MockSequence returns a handler that uses each of the specified handlers in turn.
+// The last handler is used for all subsequent requests.
+// This is useful for testing waiters and retries.
+// If no handlers are specified, requests fail the test and receive an HTTP 500 response.
+func MockSequence(handlers ...MockHandler) MockHandler {
+	if len(handlers) == 0 {
+		return func(w http.ResponseWriter, r *MockRequest) {
+			r.t.Errorf("mock server: empty handler sequence for %s %s request", r.Service, r.Operation)
+			MockErrorResponse(http.StatusInternalServerError, "MockEmptySequence", fmt.Sprintf("empty handler sequence for %s %s", r.Service, r.Operation))(w, r)
+		}
+	}
+
+	var mu sync.Mutex
+	var i int
+
+	return func(w http.ResponseWriter, r *MockRequest) {
+		mu.Lock()
+		handler := handlers[i]
+		if i < len(handlers)-1 {
+			i++
+		}
+		mu.Unlock()
+
+		handler(w, r)
+	}
+}
+
+func mockJSONContentType(r *MockRequest) string {
+	if v := r.Header.Get("Content-Type"); r.Protocol == MockProtocolJSON && v != "" {
+		return v
+	}
+
+	return "application/json"
+}
+
+func mockEscapeXML(s string) string {
+	var b strings.Builder
+	if err := xml.EscapeText(&b, []byte(s)); err != nil {
+		return s
+	}
+
+	return b.String()
+}
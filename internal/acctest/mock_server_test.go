@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestMockServerREST(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path               string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"path parameter": {
+			path:               "/lambda/2015-03-31/functions/tf-acc-test",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"Configuration":{"FunctionName":"tf-acc-test"}}`,
+		},
+		"greedy path parameter": {
+			path:               "/s3/bucket/a/b/c",
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message><RequestId>mock-request-1</RequestId></Error>`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := acctest.NewMockServer(t)
+
+			server.Handle(names.Lambda, "GET /2015-03-31/functions/{FunctionName}", func(w http.ResponseWriter, r *acctest.MockRequest) {
+				acctest.MockJSONResponse(map[string]any{
+					"Configuration": map[string]any{
+						"FunctionName": r.PathParameters["FunctionName"],
+					},
+				})(w, r)
+			})
+			server.Handle(names.S3, "GET /{Bucket}/{Key+}", acctest.MockErrorResponse(http.StatusNotFound, "NoSuchKey", "The specified key does not exist."))
+
+			response, err := http.Get(server.URL() + testCase.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer response.Body.Close()
+
+			if got, want := response.StatusCode, testCase.expectedStatusCode; got != want {
+				t.Errorf("status code = %d, want %d", got, want)
+			}
+
+			body, err := io.ReadAll(response.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := string(body), testCase.expectedBody; got != want {
+				t.Errorf("body = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestMockServerS3PathStyle(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	server := acctest.NewMockServer(t)
+
+	server.Handle(names.S3, "HEAD /{Bucket}", acctest.MockRawResponse(http.StatusOK, "", ""))
+
+	conn := server.Client(ctx, t).S3Client(ctx)
+
+	_, err := conn.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String("tf-acc-test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	requests := server.Requests(names.S3, "HEAD /{Bucket}")
+	if got, want := len(requests), 1; got != want {
+		t.Fatalf("length of requests = %d, want %d", got, want)
+	}
+
+	if got, want := requests[0].PathParameters["Bucket"], "tf-acc-test"; got != want {
+		t.Errorf("Bucket = %q, want %q", got, want)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -96,6 +97,11 @@ func isVCREnabled() bool {
 	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
 }
 
+// isVCRReplaying returns whether VCR is enabled in REPLAYING mode.
+func isVCRReplaying() bool {
+	return isVCREnabled() && os.Getenv(envVarVCRMode) == "REPLAYING"
+}
+
 func vcrMode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case "RECORDING":
@@ -164,29 +170,40 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 
 		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
 
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
+		opts := &recorder.Options{
 			CassetteName:  path,
 			Mode:          vcrMode,
 			RealTransport: httpClient.Transport,
-		})
+		}
+
+		// When replaying no requests are sent to AWS.
+		// STS GetCallerIdentity, used during provider configuration, is answered locally
+		// so that replaying does not require AWS credentials or a real account.
+		if vcrMode == recorder.ModeReplayOnly {
+			opts.RealTransport = vcrSTSTransport{}
+			opts.SkipRequestLatency = true
+		}
+
+		// Create a VCR recorder around a default HTTP client.
+		r, err := recorder.NewWithOptions(opts)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
+		if vcrMode == recorder.ModeReplayOnly {
+			r.AddPassthrough(isSTSGetCallerIdentityRequest)
+		}
 
-			return nil
-		}, recorder.AfterCaptureHook)
+		// Remove sensitive HTTP headers and scrub account IDs and secrets from recorded interactions.
+		scrubber := &vcrScrubber{}
+		r.AddHook(scrubber.afterCapture, recorder.AfterCaptureHook)
+		r.AddHook(scrubber.beforeSave, recorder.BeforeSaveHook)
 
 		// Defines how VCR will match requests to responses.
 		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
+			// Compare method and URL, ignoring any request signing query parameters.
+			if r.Method != i.Method || vcrNormalizeURL(r.URL.String()) != vcrNormalizeURL(i.URL) {
 				return false
 			}
 
@@ -203,14 +220,20 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			}
 
 			r.Body = io.NopCloser(&b)
-			body := b.String()
+			// Recorded request bodies have been scrubbed.
+			body := vcrScrub(b.String(), nil)
 			// If body matches identically, we are done.
 			if body == i.Body {
 				return true
 			}
 
+			mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil {
+				return false
+			}
+
 			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
+			switch mediaType {
 			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
 				// JSON might be the same, but reordered. Try parsing and comparing.
 				var requestJson, cassetteJson interface{}
@@ -229,7 +252,27 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 					return false
 				}
 
-				return reflect.DeepEqual(requestJson, cassetteJson)
+				return reflect.DeepEqual(vcrNormalizeJSONBody(requestJson), vcrNormalizeJSONBody(cassetteJson))
+
+			case "application/x-www-form-urlencoded":
+				// Query protocol parameters might be the same, but reordered. Try parsing and comparing.
+				requestForm, err := vcrNormalizeFormBody(body)
+				if err != nil {
+					tflog.Debug(ctx, "Failed to parse request form", map[string]interface{}{
+						"error": err,
+					})
+					return false
+				}
+
+				cassetteForm, err := vcrNormalizeFormBody(i.Body)
+				if err != nil {
+					tflog.Debug(ctx, "Failed to parse cassette form", map[string]interface{}{
+						"error": err,
+					})
+					return false
+				}
+
+				return reflect.DeepEqual(requestForm, cassetteForm)
 
 			case "application/xml":
 				// XML might be the same, but reordered. Try parsing and comparing.
@@ -458,13 +501,18 @@ func RandInt(t *testing.T) int {
 		return sdkacctest.RandInt()
 	}
 
-	s, err := vcrRandomnessSource(t)
+	return vcrRand(t).Int()
+}
 
-	if err != nil {
-		t.Fatal(err)
+// RandIntRange is a VCR-friendly replacement for acctest.RandIntRange.
+func RandIntRange(t *testing.T, min int, max int) int {
+	t.Helper()
+
+	if !isVCREnabled() {
+		return sdkacctest.RandIntRange(min, max)
 	}
 
-	return rand.New(s.source).Int()
+	return vcrRand(t).Intn(max-min) + min
 }
 
 // RandomWithPrefix is a VCR-friendly replacement for acctest.RandomWithPrefix.
@@ -473,3 +521,42 @@ func RandomWithPrefix(t *testing.T, prefix string) string {
 
 	return fmt.Sprintf("%s-%d", prefix, RandInt(t))
 }
+
+// RandString is a VCR-friendly replacement for acctest.RandString.
+func RandString(t *testing.T, strlen int) string {
+	t.Helper()
+
+	return RandStringFromCharSet(t, strlen, sdkacctest.CharSetAlphaNum)
+}
+
+// RandStringFromCharSet is a VCR-friendly replacement for acctest.RandStringFromCharSet.
+func RandStringFromCharSet(t *testing.T, strlen int, charSet string) string {
+	t.Helper()
+
+	if !isVCREnabled() {
+		return sdkacctest.RandStringFromCharSet(strlen, charSet)
+	}
+
+	r := vcrRand(t)
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[r.Intn(len(charSet))]
+	}
+
+	return string(result)
+}
+
+// vcrRand returns a random number generator using the test's VCR randomness source.
+// When replaying, the source is seeded from the seed file saved when recording so that
+// the same random values, and hence the same requests, are generated.
+func vcrRand(t *testing.T) *rand.Rand {
+	t.Helper()
+
+	s, err := vcrRandomnessSource(t)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rand.New(s.source)
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// vcrAccountID replaces AWS account IDs in recorded cassettes.
+	// It is also the account ID of the caller identity returned when replaying.
+	vcrAccountID = "123456789012"
+
+	vcrRedacted = "REDACTED"
+)
+
+var (
+	// vcrSensitiveHeaders are removed from recorded requests.
+	vcrSensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// vcrSigningQueryParameters vary between recording and replaying and are ignored when matching requests.
+	vcrSigningQueryParameters = []string{
+		"X-Amz-Algorithm",
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Expires",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+		"X-Amz-SignedHeaders",
+	}
+
+	// vcrIdempotencyTokenFields are request fields that the AWS SDKs populate with random values.
+	// They are ignored when matching requests.
+	vcrIdempotencyTokenFields = []string{
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+	}
+
+	// vcrSecretFields are request and response fields whose values are redacted from recorded cassettes.
+	vcrSecretFields = []string{
+		"MasterUserPassword",
+		"Password",
+		"SecretAccessKey",
+		"SecretBinary",
+		"SecretString",
+		"SessionToken",
+	}
+
+	vcrCallerIdentityAccountIDRegexp = regexache.MustCompile(`<Account>(\d{12})</Account>`)
+	vcrARNAccountIDRegexp            = regexache.MustCompile(`(arn:aws[a-z-]*:[a-z0-9-]+:[a-z0-9-]*:)\d{12}:`)
+	vcrSecretRegexps                 = vcrSecretFieldRegexps(vcrSecretFields)
+)
+
+type vcrSecretRegexp struct {
+	regexp      *regexp.Regexp
+	replacement string
+}
+
+// vcrSecretFieldRegexps returns regular expressions that match the values of the specified fields
+// in JSON, XML and form-encoded bodies.
+func vcrSecretFieldRegexps(fields []string) []vcrSecretRegexp {
+	var regexps []vcrSecretRegexp
+
+	for _, field := range fields {
+		regexps = append(regexps,
+			vcrSecretRegexp{regexache.MustCompile(fmt.Sprintf(`("%s"\s*:\s*)"(?:[^"\\]|\\.)*"`, field)), fmt.Sprintf(`${1}"%s"`, vcrRedacted)},
+			vcrSecretRegexp{regexache.MustCompile(fmt.Sprintf(`(<%[1]s>)[^<]*(</%[1]s>)`, field)), fmt.Sprintf(`${1}%s${2}`, vcrRedacted)},
+			vcrSecretRegexp{regexache.MustCompile(fmt.Sprintf(`((?:^|&)(?:[\w.]+\.)?%s=)[^&]*`, field)), fmt.Sprintf(`${1}%s`, vcrRedacted)},
+		)
+	}
+
+	return regexps
+}
+
+// vcrScrub replaces the specified account IDs, and the account IDs in any ARNs, with the placeholder account ID
+// and redacts secret values.
+func vcrScrub(s string, accountIDs []string) string {
+	for _, accountID := range accountIDs {
+		s = strings.ReplaceAll(s, accountID, vcrAccountID)
+	}
+
+	s = vcrARNAccountIDRegexp.ReplaceAllString(s, "${1}"+vcrAccountID+":")
+
+	for _, v := range vcrSecretRegexps {
+		s = v.regexp.ReplaceAllString(s, v.replacement)
+	}
+
+	return s
+}
+
+// vcrScrubber removes sensitive data from interactions as they are recorded.
+type vcrScrubber struct {
+	mu         sync.Mutex
+	accountIDs []string
+}
+
+// afterCapture removes sensitive headers from the interaction and notes the caller's account ID.
+func (s *vcrScrubber) afterCapture(i *cassette.Interaction) error {
+	for _, k := range vcrSensitiveHeaders {
+		delete(i.Request.Headers, k)
+	}
+
+	if m := vcrCallerIdentityAccountIDRegexp.FindStringSubmatch(i.Response.Body); m != nil {
+		s.mu.Lock()
+		s.accountIDs = tfslices.AppendUnique(s.accountIDs, m[1])
+		s.mu.Unlock()
+	}
+
+	return nil
+}
+
+// beforeSave replaces account IDs and redacts secrets in the interaction.
+func (s *vcrScrubber) beforeSave(i *cassette.Interaction) error {
+	s.mu.Lock()
+	accountIDs := slices.Clone(s.accountIDs)
+	s.mu.Unlock()
+
+	scrub := func(v string) string {
+		return vcrScrub(v, accountIDs)
+	}
+
+	i.Request.URL = scrub(i.Request.URL)
+	i.Request.RequestURI = scrub(i.Request.RequestURI)
+	i.Request.Body = scrub(i.Request.Body)
+	for k, v := range i.Request.Form {
+		i.Request.Form[k] = tfslices.ApplyToAll(v, scrub)
+	}
+	for k, v := range i.Request.Headers {
+		i.Request.Headers[k] = tfslices.ApplyToAll(v, scrub)
+	}
+
+	i.Response.Body = scrub(i.Response.Body)
+	for k, v := range i.Response.Headers {
+		i.Response.Headers[k] = tfslices.ApplyToAll(v, scrub)
+	}
+
+	return nil
+}
+
+// vcrNormalizeURL returns the specified URL without any request signing query parameters.
+func vcrNormalizeURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+
+	query := u.Query()
+	for _, k := range vcrSigningQueryParameters {
+		query.Del(k)
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// vcrNormalizeFormBody returns the parsed form-encoded body without any idempotency tokens.
+func vcrNormalizeFormBody(s string) (url.Values, error) {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range vcrIdempotencyTokenFields {
+		values.Del(k)
+	}
+
+	return values, nil
+}
+
+// vcrNormalizeJSONBody removes any top-level idempotency tokens from the unmarshaled JSON body.
+func vcrNormalizeJSONBody(v any) any {
+	if m, ok := v.(map[string]any); ok {
+		for _, k := range vcrIdempotencyTokenFields {
+			delete(m, k)
+		}
+	}
+
+	return v
+}
+
+// isSTSGetCallerIdentityRequest returns whether the specified request is an STS GetCallerIdentity request.
+func isSTSGetCallerIdentityRequest(r *http.Request) bool {
+	if !strings.HasPrefix(r.URL.Host, "sts.") || r.Body == nil {
+		return false
+	}
+
+	var b bytes.Buffer
+	if _, err := b.ReadFrom(r.Body); err != nil {
+		return false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(b.Bytes()))
+
+	values, err := url.ParseQuery(b.String())
+
+	return err == nil && values.Get("Action") == "GetCallerIdentity"
+}
+
+// vcrSTSTransport is an http.RoundTripper that answers STS GetCallerIdentity requests without network access.
+// This allows the provider to be configured when replaying without AWS credentials.
+// All other requests fail, as when replaying they must be served from the cassette.
+type vcrSTSTransport struct{}
+
+func (vcrSTSTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !isSTSGetCallerIdentityRequest(r) {
+		return nil, fmt.Errorf("%s %s: %w", r.Method, r.URL, cassette.ErrInteractionNotFound)
+	}
+
+	body := fmt.Sprintf(`<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:%[1]s:iam::%[2]s:user/vcr</Arn>
+    <UserId>AIDAVCRREPLAYEXAMPLE</UserId>
+    <Account>%[2]s</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>00000000-0000-0000-0000-000000000000</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`, Partition(), vcrAccountID)
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"text/xml"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       r,
+	}, nil
+}
@@ -4,9 +4,14 @@
 package acctest_test
 
 import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
 func TestRandInt(t *testing.T) {
@@ -52,3 +57,139 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestRandString(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	t.Setenv("VCR_PATH", t.TempDir())
+
+	t.Setenv("VCR_MODE", "RECORDING")
+	rec1 := acctest.RandString(t, 10)
+	rec2 := acctest.RandString(t, 10)
+	acctest.CloseVCRRecorder(ctx, t)
+
+	t.Setenv("VCR_MODE", "REPLAYING")
+	rep1 := acctest.RandString(t, 10)
+	rep2 := acctest.RandString(t, 10)
+
+	if rep1 != rec1 {
+		t.Errorf("REPLAYING: %s, RECORDING: %s", rep1, rec1)
+	}
+	if rep2 != rec2 {
+		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
+	}
+}
+
+func TestVCRScrub(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input      string
+		accountIDs []string
+		expected   string
+	}{
+		"empty": {},
+		"no sensitive data": {
+			input:    `{"Name":"tf-acc-test"}`,
+			expected: `{"Name":"tf-acc-test"}`,
+		},
+		"account ID": {
+			input:      `<OwnerId>111122223333</OwnerId>`,
+			accountIDs: []string{"111122223333"},
+			expected:   `<OwnerId>123456789012</OwnerId>`,
+		},
+		"ARN": {
+			input:    `{"Arn":"arn:aws-us-gov:iam::444455556666:role/tf-acc-test"}`,
+			expected: `{"Arn":"arn:aws-us-gov:iam::123456789012:role/tf-acc-test"}`,
+		},
+		"JSON secret": {
+			input:    `{"Name":"tf-acc-test","SecretString": "s3cr\"et"}`,
+			expected: `{"Name":"tf-acc-test","SecretString": "REDACTED"}`,
+		},
+		"XML secret": {
+			input:    `<Credentials><SecretAccessKey>abc/def+ghi</SecretAccessKey><SessionToken>xyz</SessionToken></Credentials>`,
+			expected: `<Credentials><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>`,
+		},
+		"form secret": {
+			input:    `Action=CreateDBInstance&MasterUserPassword=s3cret&DBInstanceIdentifier=tf-acc-test`,
+			expected: `Action=CreateDBInstance&MasterUserPassword=REDACTED&DBInstanceIdentifier=tf-acc-test`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.VCRScrub(testCase.input, testCase.accountIDs), testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRNormalizeURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"no query": {
+			input:    "https://ec2.us-west-2.amazonaws.com/",
+			expected: "https://ec2.us-west-2.amazonaws.com/",
+		},
+		"presigned": {
+			input:    "https://bucket.s3.us-west-2.amazonaws.com/key?X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Date=20240101T000000Z&X-Amz-Signature=abc&versionId=1",
+			expected: "https://bucket.s3.us-west-2.amazonaws.com/key?versionId=1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.VCRNormalizeURL(testCase.input), testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRSTSTransport(t *testing.T) {
+	t.Parallel()
+
+	var transport acctest.VCRSTSTransport
+
+	request, err := http.NewRequest(http.MethodPost, "https://sts.us-west-2.amazonaws.com/", strings.NewReader("Action=GetCallerIdentity&Version=2011-06-15"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "<Account>" + acctest.VCRAccountID + "</Account>"; !strings.Contains(string(body), want) {
+		t.Errorf("response %q does not contain %q", body, want)
+	}
+
+	request, err = http.NewRequest(http.MethodPost, "https://ec2.us-west-2.amazonaws.com/", strings.NewReader("Action=DescribeVpcs&Version=2016-11-15"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := transport.RoundTrip(request); !errors.Is(err, cassette.ErrInteractionNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// validateAssumeRoleChain ensures that every role in a chain of `assume_role` blocks has a role ARN.
+// Without this check a block with no role ARN would be silently skipped and later roles would be assumed with the wrong credentials.
+func (c *Config) validateAssumeRoleChain() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	n := len(c.AssumeRole)
+
+	if n < 2 {
+		return diags
+	}
+
+	for i, ar := range c.AssumeRole {
+		if ar.RoleARN == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot assume IAM Role (assume_role %d of %d)", i+1, n),
+				Detail:   "IAM Role ARN not set",
+			})
+		}
+	}
+
+	return diags
+}
+
+// assumeRoleChain assumes the second and subsequent roles in the configured `assume_role` chain.
+// Each role is assumed using the credentials obtained from the previous role.
+// The AWS configuration's credentials are replaced with those of the final role in the chain.
+func (c *Config) assumeRoleChain(ctx context.Context, cfg *aws_sdkv2.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	n := len(c.AssumeRole)
+
+	for i := 1; i < n; i++ {
+		ar := c.AssumeRole[i]
+
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.index":           i,
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		client := sts_sdkv2.NewFromConfig(*cfg, func(o *sts_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.STS]; endpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(endpoint)
+			}
+
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+		})
+
+		provider := stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(opts *stscreds.AssumeRoleOptions) {
+			expandAssumeRoleOptions(opts, ar)
+		})
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot assume IAM Role (assume_role %d of %d)", i+1, n),
+				Detail:   fmt.Sprintf("IAM Role (%s) cannot be assumed: %s", ar.RoleARN, err),
+			})
+		}
+
+		cfg.Credentials = aws_sdkv2.NewCredentialsCache(provider)
+	}
+
+	return diags
+}
+
+func expandAssumeRoleOptions(opts *stscreds.AssumeRoleOptions, ar awsbase.AssumeRole) {
+	opts.RoleSessionName = ar.SessionName
+	opts.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		opts.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		opts.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		opts.PolicyARNs = append(opts.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	if ar.SourceIdentity != "" {
+		opts.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+
+	for k, v := range ar.Tags {
+		opts.Tags = append(opts.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	if len(ar.TransitiveTagKeys) > 0 {
+		opts.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+}
@@ -36,6 +36,7 @@ type AWSClient struct {
 	Partition         string
 	Region            string
 	ServicePackages   map[string]ServicePackage
+	TagPolicyConfig   *tftags.PolicyConfig
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
@@ -45,6 +46,9 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	rateLimiters              map[string]*serviceRateLimiters // From provider configuration.
+	regionalClients           map[string]any                  // Keyed by service package name and AWS Region.
+	retryPolicies             map[string]RetryPolicy          // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -252,11 +256,25 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig, session := c.awsConfig, c.session
+	if v, ok := c.retryPolicies[servicePackageName]; ok {
+		awsConfig = v.awsConfig(awsConfig)
+		if session != nil {
+			session = v.session(session)
+		}
+	}
+	if v, ok := c.rateLimiters[servicePackageName]; ok {
+		awsConfig = v.awsConfig(awsConfig)
+		if session != nil {
+			session = v.session(session)
+		}
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.resolveEndpoint(ctx, servicePackageName),
 		"partition":        c.Partition,
-		"session":          c.session,
+		"session":          session,
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -437,3 +455,58 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 
 	return client, nil
 }
+
+// clientForRegion returns the AWS SDK for Go v2 API client for the specified service and AWS Region.
+// If the specified region is the default the default service client is returned.
+// Otherwise a client is created using the service's configuration (endpoint override, retry policy, rate limits and HTTP client)
+// with the specified region, and is cached. The AWSClient lock is held.
+// This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
+func clientForRegion[T any](ctx context.Context, c *AWSClient, servicePackageName, region string) (T, error) {
+	if region == "" || region == c.Region {
+		return client[T](ctx, c, servicePackageName, make(map[string]any))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock() // Runs at function exit, NOT block.
+
+	key := servicePackageName + "/" + region
+	if raw, ok := c.regionalClients[key]; ok {
+		if client, ok := raw.(T); ok {
+			return client, nil
+		} else {
+			var zero T
+			return zero, fmt.Errorf("AWS SDK v2 API client (%s, %s): %T, want %T", servicePackageName, region, raw, zero)
+		}
+	}
+
+	sp, ok := c.ServicePackages[servicePackageName]
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("unknown service package: %s", servicePackageName)
+	}
+
+	v, ok := sp.(interface {
+		NewClient(context.Context, map[string]any) (T, error)
+	})
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
+	}
+
+	config := c.apiClientConfig(ctx, servicePackageName)
+	awsConfig := config["aws_sdkv2_config"].(*aws_sdkv2.Config).Copy()
+	awsConfig.Region = region
+	config["aws_sdkv2_config"] = &awsConfig
+	client, err := v.NewClient(ctx, config)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]any)
+	}
+	c.regionalClients[key] = client
+
+	return client, nil
+}
@@ -257,14 +257,26 @@ func (c *AWSClient) ACMClient(ctx context.Context) *acm_sdkv2.Client {
 	return errs.Must(client[*acm_sdkv2.Client](ctx, c, names.ACM, make(map[string]any)))
 }
 
+func (c *AWSClient) ACMClientForRegion(ctx context.Context, region string) *acm_sdkv2.Client {
+	return errs.Must(clientForRegion[*acm_sdkv2.Client](ctx, c, names.ACM, region))
+}
+
 func (c *AWSClient) ACMPCAClient(ctx context.Context) *acmpca_sdkv2.Client {
 	return errs.Must(client[*acmpca_sdkv2.Client](ctx, c, names.ACMPCA, make(map[string]any)))
 }
 
+func (c *AWSClient) ACMPCAClientForRegion(ctx context.Context, region string) *acmpca_sdkv2.Client {
+	return errs.Must(clientForRegion[*acmpca_sdkv2.Client](ctx, c, names.ACMPCA, region))
+}
+
 func (c *AWSClient) AMPClient(ctx context.Context) *amp_sdkv2.Client {
 	return errs.Must(client[*amp_sdkv2.Client](ctx, c, names.AMP, make(map[string]any)))
 }
 
+func (c *AWSClient) AMPClientForRegion(ctx context.Context, region string) *amp_sdkv2.Client {
+	return errs.Must(clientForRegion[*amp_sdkv2.Client](ctx, c, names.AMP, region))
+}
+
 func (c *AWSClient) APIGatewayConn(ctx context.Context) *apigateway_sdkv1.APIGateway {
 	return errs.Must(conn[*apigateway_sdkv1.APIGateway](ctx, c, names.APIGateway, make(map[string]any)))
 }
@@ -277,10 +289,18 @@ func (c *AWSClient) AccessAnalyzerClient(ctx context.Context) *accessanalyzer_sd
 	return errs.Must(client[*accessanalyzer_sdkv2.Client](ctx, c, names.AccessAnalyzer, make(map[string]any)))
 }
 
+func (c *AWSClient) AccessAnalyzerClientForRegion(ctx context.Context, region string) *accessanalyzer_sdkv2.Client {
+	return errs.Must(clientForRegion[*accessanalyzer_sdkv2.Client](ctx, c, names.AccessAnalyzer, region))
+}
+
 func (c *AWSClient) AccountClient(ctx context.Context) *account_sdkv2.Client {
 	return errs.Must(client[*account_sdkv2.Client](ctx, c, names.Account, make(map[string]any)))
 }
 
+func (c *AWSClient) AccountClientForRegion(ctx context.Context, region string) *account_sdkv2.Client {
+	return errs.Must(clientForRegion[*account_sdkv2.Client](ctx, c, names.Account, region))
+}
+
 func (c *AWSClient) AmplifyConn(ctx context.Context) *amplify_sdkv1.Amplify {
 	return errs.Must(conn[*amplify_sdkv1.Amplify](ctx, c, names.Amplify, make(map[string]any)))
 }
@@ -297,14 +317,26 @@ func (c *AWSClient) AppConfigClient(ctx context.Context) *appconfig_sdkv2.Client
 	return errs.Must(client[*appconfig_sdkv2.Client](ctx, c, names.AppConfig, make(map[string]any)))
 }
 
+func (c *AWSClient) AppConfigClientForRegion(ctx context.Context, region string) *appconfig_sdkv2.Client {
+	return errs.Must(clientForRegion[*appconfig_sdkv2.Client](ctx, c, names.AppConfig, region))
+}
+
 func (c *AWSClient) AppFabricClient(ctx context.Context) *appfabric_sdkv2.Client {
 	return errs.Must(client[*appfabric_sdkv2.Client](ctx, c, names.AppFabric, make(map[string]any)))
 }
 
+func (c *AWSClient) AppFabricClientForRegion(ctx context.Context, region string) *appfabric_sdkv2.Client {
+	return errs.Must(clientForRegion[*appfabric_sdkv2.Client](ctx, c, names.AppFabric, region))
+}
+
 func (c *AWSClient) AppFlowClient(ctx context.Context) *appflow_sdkv2.Client {
 	return errs.Must(client[*appflow_sdkv2.Client](ctx, c, names.AppFlow, make(map[string]any)))
 }
 
+func (c *AWSClient) AppFlowClientForRegion(ctx context.Context, region string) *appflow_sdkv2.Client {
+	return errs.Must(clientForRegion[*appflow_sdkv2.Client](ctx, c, names.AppFlow, region))
+}
+
 func (c *AWSClient) AppIntegrationsConn(ctx context.Context) *appintegrationsservice_sdkv1.AppIntegrationsService {
 	return errs.Must(conn[*appintegrationsservice_sdkv1.AppIntegrationsService](ctx, c, names.AppIntegrations, make(map[string]any)))
 }
@@ -317,6 +349,10 @@ func (c *AWSClient) AppRunnerClient(ctx context.Context) *apprunner_sdkv2.Client
 	return errs.Must(client[*apprunner_sdkv2.Client](ctx, c, names.AppRunner, make(map[string]any)))
 }
 
+func (c *AWSClient) AppRunnerClientForRegion(ctx context.Context, region string) *apprunner_sdkv2.Client {
+	return errs.Must(clientForRegion[*apprunner_sdkv2.Client](ctx, c, names.AppRunner, region))
+}
+
 func (c *AWSClient) AppStreamConn(ctx context.Context) *appstream_sdkv1.AppStream {
 	return errs.Must(conn[*appstream_sdkv1.AppStream](ctx, c, names.AppStream, make(map[string]any)))
 }
@@ -333,10 +369,18 @@ func (c *AWSClient) AthenaClient(ctx context.Context) *athena_sdkv2.Client {
 	return errs.Must(client[*athena_sdkv2.Client](ctx, c, names.Athena, make(map[string]any)))
 }
 
+func (c *AWSClient) AthenaClientForRegion(ctx context.Context, region string) *athena_sdkv2.Client {
+	return errs.Must(clientForRegion[*athena_sdkv2.Client](ctx, c, names.Athena, region))
+}
+
 func (c *AWSClient) AuditManagerClient(ctx context.Context) *auditmanager_sdkv2.Client {
 	return errs.Must(client[*auditmanager_sdkv2.Client](ctx, c, names.AuditManager, make(map[string]any)))
 }
 
+func (c *AWSClient) AuditManagerClientForRegion(ctx context.Context, region string) *auditmanager_sdkv2.Client {
+	return errs.Must(clientForRegion[*auditmanager_sdkv2.Client](ctx, c, names.AuditManager, region))
+}
+
 func (c *AWSClient) AutoScalingConn(ctx context.Context) *autoscaling_sdkv1.AutoScaling {
 	return errs.Must(conn[*autoscaling_sdkv1.AutoScaling](ctx, c, names.AutoScaling, make(map[string]any)))
 }
@@ -357,18 +401,34 @@ func (c *AWSClient) BatchClient(ctx context.Context) *batch_sdkv2.Client {
 	return errs.Must(client[*batch_sdkv2.Client](ctx, c, names.Batch, make(map[string]any)))
 }
 
+func (c *AWSClient) BatchClientForRegion(ctx context.Context, region string) *batch_sdkv2.Client {
+	return errs.Must(clientForRegion[*batch_sdkv2.Client](ctx, c, names.Batch, region))
+}
+
 func (c *AWSClient) BedrockClient(ctx context.Context) *bedrock_sdkv2.Client {
 	return errs.Must(client[*bedrock_sdkv2.Client](ctx, c, names.Bedrock, make(map[string]any)))
 }
 
+func (c *AWSClient) BedrockClientForRegion(ctx context.Context, region string) *bedrock_sdkv2.Client {
+	return errs.Must(clientForRegion[*bedrock_sdkv2.Client](ctx, c, names.Bedrock, region))
+}
+
 func (c *AWSClient) BedrockAgentClient(ctx context.Context) *bedrockagent_sdkv2.Client {
 	return errs.Must(client[*bedrockagent_sdkv2.Client](ctx, c, names.BedrockAgent, make(map[string]any)))
 }
 
+func (c *AWSClient) BedrockAgentClientForRegion(ctx context.Context, region string) *bedrockagent_sdkv2.Client {
+	return errs.Must(clientForRegion[*bedrockagent_sdkv2.Client](ctx, c, names.BedrockAgent, region))
+}
+
 func (c *AWSClient) BudgetsClient(ctx context.Context) *budgets_sdkv2.Client {
 	return errs.Must(client[*budgets_sdkv2.Client](ctx, c, names.Budgets, make(map[string]any)))
 }
 
+func (c *AWSClient) BudgetsClientForRegion(ctx context.Context, region string) *budgets_sdkv2.Client {
+	return errs.Must(clientForRegion[*budgets_sdkv2.Client](ctx, c, names.Budgets, region))
+}
+
 func (c *AWSClient) CEConn(ctx context.Context) *costexplorer_sdkv1.CostExplorer {
 	return errs.Must(conn[*costexplorer_sdkv1.CostExplorer](ctx, c, names.CE, make(map[string]any)))
 }
@@ -377,6 +437,10 @@ func (c *AWSClient) CURClient(ctx context.Context) *costandusagereportservice_sd
 	return errs.Must(client[*costandusagereportservice_sdkv2.Client](ctx, c, names.CUR, make(map[string]any)))
 }
 
+func (c *AWSClient) CURClientForRegion(ctx context.Context, region string) *costandusagereportservice_sdkv2.Client {
+	return errs.Must(clientForRegion[*costandusagereportservice_sdkv2.Client](ctx, c, names.CUR, region))
+}
+
 func (c *AWSClient) ChimeConn(ctx context.Context) *chime_sdkv1.Chime {
 	return errs.Must(conn[*chime_sdkv1.Chime](ctx, c, names.Chime, make(map[string]any)))
 }
@@ -385,22 +449,42 @@ func (c *AWSClient) ChimeSDKMediaPipelinesClient(ctx context.Context) *chimesdkm
 	return errs.Must(client[*chimesdkmediapipelines_sdkv2.Client](ctx, c, names.ChimeSDKMediaPipelines, make(map[string]any)))
 }
 
+func (c *AWSClient) ChimeSDKMediaPipelinesClientForRegion(ctx context.Context, region string) *chimesdkmediapipelines_sdkv2.Client {
+	return errs.Must(clientForRegion[*chimesdkmediapipelines_sdkv2.Client](ctx, c, names.ChimeSDKMediaPipelines, region))
+}
+
 func (c *AWSClient) ChimeSDKVoiceClient(ctx context.Context) *chimesdkvoice_sdkv2.Client {
 	return errs.Must(client[*chimesdkvoice_sdkv2.Client](ctx, c, names.ChimeSDKVoice, make(map[string]any)))
 }
 
+func (c *AWSClient) ChimeSDKVoiceClientForRegion(ctx context.Context, region string) *chimesdkvoice_sdkv2.Client {
+	return errs.Must(clientForRegion[*chimesdkvoice_sdkv2.Client](ctx, c, names.ChimeSDKVoice, region))
+}
+
 func (c *AWSClient) CleanRoomsClient(ctx context.Context) *cleanrooms_sdkv2.Client {
 	return errs.Must(client[*cleanrooms_sdkv2.Client](ctx, c, names.CleanRooms, make(map[string]any)))
 }
 
+func (c *AWSClient) CleanRoomsClientForRegion(ctx context.Context, region string) *cleanrooms_sdkv2.Client {
+	return errs.Must(clientForRegion[*cleanrooms_sdkv2.Client](ctx, c, names.CleanRooms, region))
+}
+
 func (c *AWSClient) Cloud9Client(ctx context.Context) *cloud9_sdkv2.Client {
 	return errs.Must(client[*cloud9_sdkv2.Client](ctx, c, names.Cloud9, make(map[string]any)))
 }
 
+func (c *AWSClient) Cloud9ClientForRegion(ctx context.Context, region string) *cloud9_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloud9_sdkv2.Client](ctx, c, names.Cloud9, region))
+}
+
 func (c *AWSClient) CloudControlClient(ctx context.Context) *cloudcontrol_sdkv2.Client {
 	return errs.Must(client[*cloudcontrol_sdkv2.Client](ctx, c, names.CloudControl, make(map[string]any)))
 }
 
+func (c *AWSClient) CloudControlClientForRegion(ctx context.Context, region string) *cloudcontrol_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloudcontrol_sdkv2.Client](ctx, c, names.CloudControl, region))
+}
+
 func (c *AWSClient) CloudFormationConn(ctx context.Context) *cloudformation_sdkv1.CloudFormation {
 	return errs.Must(conn[*cloudformation_sdkv1.CloudFormation](ctx, c, names.CloudFormation, make(map[string]any)))
 }
@@ -413,62 +497,122 @@ func (c *AWSClient) CloudFrontClient(ctx context.Context) *cloudfront_sdkv2.Clie
 	return errs.Must(client[*cloudfront_sdkv2.Client](ctx, c, names.CloudFront, make(map[string]any)))
 }
 
+func (c *AWSClient) CloudFrontClientForRegion(ctx context.Context, region string) *cloudfront_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloudfront_sdkv2.Client](ctx, c, names.CloudFront, region))
+}
+
 func (c *AWSClient) CloudFrontKeyValueStoreClient(ctx context.Context) *cloudfrontkeyvaluestore_sdkv2.Client {
 	return errs.Must(client[*cloudfrontkeyvaluestore_sdkv2.Client](ctx, c, names.CloudFrontKeyValueStore, make(map[string]any)))
 }
 
+func (c *AWSClient) CloudFrontKeyValueStoreClientForRegion(ctx context.Context, region string) *cloudfrontkeyvaluestore_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloudfrontkeyvaluestore_sdkv2.Client](ctx, c, names.CloudFrontKeyValueStore, region))
+}
+
 func (c *AWSClient) CloudHSMV2Client(ctx context.Context) *cloudhsmv2_sdkv2.Client {
 	return errs.Must(client[*cloudhsmv2_sdkv2.Client](ctx, c, names.CloudHSMV2, make(map[string]any)))
 }
 
+func (c *AWSClient) CloudHSMV2ClientForRegion(ctx context.Context, region string) *cloudhsmv2_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloudhsmv2_sdkv2.Client](ctx, c, names.CloudHSMV2, region))
+}
+
 func (c *AWSClient) CloudSearchClient(ctx context.Context) *cloudsearch_sdkv2.Client {
 	return errs.Must(client[*cloudsearch_sdkv2.Client](ctx, c, names.CloudSearch, make(map[string]any)))
 }
 
+func (c *AWSClient) CloudSearchClientForRegion(ctx context.Context, region string) *cloudsearch_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloudsearch_sdkv2.Client](ctx, c, names.CloudSearch, region))
+}
+
 func (c *AWSClient) CloudTrailClient(ctx context.Context) *cloudtrail_sdkv2.Client {
 	return errs.Must(client[*cloudtrail_sdkv2.Client](ctx, c, names.CloudTrail, make(map[string]any)))
 }
 
+func (c *AWSClient) CloudTrailClientForRegion(ctx context.Context, region string) *cloudtrail_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloudtrail_sdkv2.Client](ctx, c, names.CloudTrail, region))
+}
+
 func (c *AWSClient) CloudWatchClient(ctx context.Context) *cloudwatch_sdkv2.Client {
 	return errs.Must(client[*cloudwatch_sdkv2.Client](ctx, c, names.CloudWatch, make(map[string]any)))
 }
 
+func (c *AWSClient) CloudWatchClientForRegion(ctx context.Context, region string) *cloudwatch_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloudwatch_sdkv2.Client](ctx, c, names.CloudWatch, region))
+}
+
 func (c *AWSClient) CodeArtifactClient(ctx context.Context) *codeartifact_sdkv2.Client {
 	return errs.Must(client[*codeartifact_sdkv2.Client](ctx, c, names.CodeArtifact, make(map[string]any)))
 }
 
+func (c *AWSClient) CodeArtifactClientForRegion(ctx context.Context, region string) *codeartifact_sdkv2.Client {
+	return errs.Must(clientForRegion[*codeartifact_sdkv2.Client](ctx, c, names.CodeArtifact, region))
+}
+
 func (c *AWSClient) CodeBuildClient(ctx context.Context) *codebuild_sdkv2.Client {
 	return errs.Must(client[*codebuild_sdkv2.Client](ctx, c, names.CodeBuild, make(map[string]any)))
 }
 
+func (c *AWSClient) CodeBuildClientForRegion(ctx context.Context, region string) *codebuild_sdkv2.Client {
+	return errs.Must(clientForRegion[*codebuild_sdkv2.Client](ctx, c, names.CodeBuild, region))
+}
+
 func (c *AWSClient) CodeCatalystClient(ctx context.Context) *codecatalyst_sdkv2.Client {
 	return errs.Must(client[*codecatalyst_sdkv2.Client](ctx, c, names.CodeCatalyst, make(map[string]any)))
 }
 
+func (c *AWSClient) CodeCatalystClientForRegion(ctx context.Context, region string) *codecatalyst_sdkv2.Client {
+	return errs.Must(clientForRegion[*codecatalyst_sdkv2.Client](ctx, c, names.CodeCatalyst, region))
+}
+
 func (c *AWSClient) CodeCommitClient(ctx context.Context) *codecommit_sdkv2.Client {
 	return errs.Must(client[*codecommit_sdkv2.Client](ctx, c, names.CodeCommit, make(map[string]any)))
 }
 
+func (c *AWSClient) CodeCommitClientForRegion(ctx context.Context, region string) *codecommit_sdkv2.Client {
+	return errs.Must(clientForRegion[*codecommit_sdkv2.Client](ctx, c, names.CodeCommit, region))
+}
+
 func (c *AWSClient) CodeGuruProfilerClient(ctx context.Context) *codeguruprofiler_sdkv2.Client {
 	return errs.Must(client[*codeguruprofiler_sdkv2.Client](ctx, c, names.CodeGuruProfiler, make(map[string]any)))
 }
 
+func (c *AWSClient) CodeGuruProfilerClientForRegion(ctx context.Context, region string) *codeguruprofiler_sdkv2.Client {
+	return errs.Must(clientForRegion[*codeguruprofiler_sdkv2.Client](ctx, c, names.CodeGuruProfiler, region))
+}
+
 func (c *AWSClient) CodeGuruReviewerClient(ctx context.Context) *codegurureviewer_sdkv2.Client {
 	return errs.Must(client[*codegurureviewer_sdkv2.Client](ctx, c, names.CodeGuruReviewer, make(map[string]any)))
 }
 
+func (c *AWSClient) CodeGuruReviewerClientForRegion(ctx context.Context, region string) *codegurureviewer_sdkv2.Client {
+	return errs.Must(clientForRegion[*codegurureviewer_sdkv2.Client](ctx, c, names.CodeGuruReviewer, region))
+}
+
 func (c *AWSClient) CodePipelineClient(ctx context.Context) *codepipeline_sdkv2.Client {
 	return errs.Must(client[*codepipeline_sdkv2.Client](ctx, c, names.CodePipeline, make(map[string]any)))
 }
 
+func (c *AWSClient) CodePipelineClientForRegion(ctx context.Context, region string) *codepipeline_sdkv2.Client {
+	return errs.Must(clientForRegion[*codepipeline_sdkv2.Client](ctx, c, names.CodePipeline, region))
+}
+
 func (c *AWSClient) CodeStarConnectionsClient(ctx context.Context) *codestarconnections_sdkv2.Client {
 	return errs.Must(client[*codestarconnections_sdkv2.Client](ctx, c, names.CodeStarConnections, make(map[string]any)))
 }
 
+func (c *AWSClient) CodeStarConnectionsClientForRegion(ctx context.Context, region string) *codestarconnections_sdkv2.Client {
+	return errs.Must(clientForRegion[*codestarconnections_sdkv2.Client](ctx, c, names.CodeStarConnections, region))
+}
+
 func (c *AWSClient) CodeStarNotificationsClient(ctx context.Context) *codestarnotifications_sdkv2.Client {
 	return errs.Must(client[*codestarnotifications_sdkv2.Client](ctx, c, names.CodeStarNotifications, make(map[string]any)))
 }
 
+func (c *AWSClient) CodeStarNotificationsClientForRegion(ctx context.Context, region string) *codestarnotifications_sdkv2.Client {
+	return errs.Must(clientForRegion[*codestarnotifications_sdkv2.Client](ctx, c, names.CodeStarNotifications, region))
+}
+
 func (c *AWSClient) CognitoIDPConn(ctx context.Context) *cognitoidentityprovider_sdkv1.CognitoIdentityProvider {
 	return errs.Must(conn[*cognitoidentityprovider_sdkv1.CognitoIdentityProvider](ctx, c, names.CognitoIDP, make(map[string]any)))
 }
@@ -477,18 +621,34 @@ func (c *AWSClient) CognitoIdentityClient(ctx context.Context) *cognitoidentity_
 	return errs.Must(client[*cognitoidentity_sdkv2.Client](ctx, c, names.CognitoIdentity, make(map[string]any)))
 }
 
+func (c *AWSClient) CognitoIdentityClientForRegion(ctx context.Context, region string) *cognitoidentity_sdkv2.Client {
+	return errs.Must(clientForRegion[*cognitoidentity_sdkv2.Client](ctx, c, names.CognitoIdentity, region))
+}
+
 func (c *AWSClient) ComprehendClient(ctx context.Context) *comprehend_sdkv2.Client {
 	return errs.Must(client[*comprehend_sdkv2.Client](ctx, c, names.Comprehend, make(map[string]any)))
 }
 
+func (c *AWSClient) ComprehendClientForRegion(ctx context.Context, region string) *comprehend_sdkv2.Client {
+	return errs.Must(clientForRegion[*comprehend_sdkv2.Client](ctx, c, names.Comprehend, region))
+}
+
 func (c *AWSClient) ComputeOptimizerClient(ctx context.Context) *computeoptimizer_sdkv2.Client {
 	return errs.Must(client[*computeoptimizer_sdkv2.Client](ctx, c, names.ComputeOptimizer, make(map[string]any)))
 }
 
+func (c *AWSClient) ComputeOptimizerClientForRegion(ctx context.Context, region string) *computeoptimizer_sdkv2.Client {
+	return errs.Must(clientForRegion[*computeoptimizer_sdkv2.Client](ctx, c, names.ComputeOptimizer, region))
+}
+
 func (c *AWSClient) ConfigServiceClient(ctx context.Context) *configservice_sdkv2.Client {
 	return errs.Must(client[*configservice_sdkv2.Client](ctx, c, names.ConfigService, make(map[string]any)))
 }
 
+func (c *AWSClient) ConfigServiceClientForRegion(ctx context.Context, region string) *configservice_sdkv2.Client {
+	return errs.Must(clientForRegion[*configservice_sdkv2.Client](ctx, c, names.ConfigService, region))
+}
+
 func (c *AWSClient) ConnectConn(ctx context.Context) *connect_sdkv1.Connect {
 	return errs.Must(conn[*connect_sdkv1.Connect](ctx, c, names.Connect, make(map[string]any)))
 }
@@ -497,22 +657,42 @@ func (c *AWSClient) ConnectCasesClient(ctx context.Context) *connectcases_sdkv2.
 	return errs.Must(client[*connectcases_sdkv2.Client](ctx, c, names.ConnectCases, make(map[string]any)))
 }
 
+func (c *AWSClient) ConnectCasesClientForRegion(ctx context.Context, region string) *connectcases_sdkv2.Client {
+	return errs.Must(clientForRegion[*connectcases_sdkv2.Client](ctx, c, names.ConnectCases, region))
+}
+
 func (c *AWSClient) ControlTowerClient(ctx context.Context) *controltower_sdkv2.Client {
 	return errs.Must(client[*controltower_sdkv2.Client](ctx, c, names.ControlTower, make(map[string]any)))
 }
 
+func (c *AWSClient) ControlTowerClientForRegion(ctx context.Context, region string) *controltower_sdkv2.Client {
+	return errs.Must(clientForRegion[*controltower_sdkv2.Client](ctx, c, names.ControlTower, region))
+}
+
 func (c *AWSClient) CostOptimizationHubClient(ctx context.Context) *costoptimizationhub_sdkv2.Client {
 	return errs.Must(client[*costoptimizationhub_sdkv2.Client](ctx, c, names.CostOptimizationHub, make(map[string]any)))
 }
 
+func (c *AWSClient) CostOptimizationHubClientForRegion(ctx context.Context, region string) *costoptimizationhub_sdkv2.Client {
+	return errs.Must(clientForRegion[*costoptimizationhub_sdkv2.Client](ctx, c, names.CostOptimizationHub, region))
+}
+
 func (c *AWSClient) CustomerProfilesClient(ctx context.Context) *customerprofiles_sdkv2.Client {
 	return errs.Must(client[*customerprofiles_sdkv2.Client](ctx, c, names.CustomerProfiles, make(map[string]any)))
 }
 
+func (c *AWSClient) CustomerProfilesClientForRegion(ctx context.Context, region string) *customerprofiles_sdkv2.Client {
+	return errs.Must(clientForRegion[*customerprofiles_sdkv2.Client](ctx, c, names.CustomerProfiles, region))
+}
+
 func (c *AWSClient) DAXClient(ctx context.Context) *dax_sdkv2.Client {
 	return errs.Must(client[*dax_sdkv2.Client](ctx, c, names.DAX, make(map[string]any)))
 }
 
+func (c *AWSClient) DAXClientForRegion(ctx context.Context, region string) *dax_sdkv2.Client {
+	return errs.Must(clientForRegion[*dax_sdkv2.Client](ctx, c, names.DAX, region))
+}
+
 func (c *AWSClient) DLMConn(ctx context.Context) *dlm_sdkv1.DLM {
 	return errs.Must(conn[*dlm_sdkv1.DLM](ctx, c, names.DLM, make(map[string]any)))
 }
@@ -529,6 +709,10 @@ func (c *AWSClient) DSClient(ctx context.Context) *directoryservice_sdkv2.Client
 	return errs.Must(client[*directoryservice_sdkv2.Client](ctx, c, names.DS, make(map[string]any)))
 }
 
+func (c *AWSClient) DSClientForRegion(ctx context.Context, region string) *directoryservice_sdkv2.Client {
+	return errs.Must(clientForRegion[*directoryservice_sdkv2.Client](ctx, c, names.DS, region))
+}
+
 func (c *AWSClient) DataExchangeConn(ctx context.Context) *dataexchange_sdkv1.DataExchange {
 	return errs.Must(conn[*dataexchange_sdkv1.DataExchange](ctx, c, names.DataExchange, make(map[string]any)))
 }
@@ -545,10 +729,18 @@ func (c *AWSClient) DataZoneClient(ctx context.Context) *datazone_sdkv2.Client {
 	return errs.Must(client[*datazone_sdkv2.Client](ctx, c, names.DataZone, make(map[string]any)))
 }
 
+func (c *AWSClient) DataZoneClientForRegion(ctx context.Context, region string) *datazone_sdkv2.Client {
+	return errs.Must(clientForRegion[*datazone_sdkv2.Client](ctx, c, names.DataZone, region))
+}
+
 func (c *AWSClient) DeployClient(ctx context.Context) *codedeploy_sdkv2.Client {
 	return errs.Must(client[*codedeploy_sdkv2.Client](ctx, c, names.Deploy, make(map[string]any)))
 }
 
+func (c *AWSClient) DeployClientForRegion(ctx context.Context, region string) *codedeploy_sdkv2.Client {
+	return errs.Must(clientForRegion[*codedeploy_sdkv2.Client](ctx, c, names.Deploy, region))
+}
+
 func (c *AWSClient) DetectiveConn(ctx context.Context) *detective_sdkv1.Detective {
 	return errs.Must(conn[*detective_sdkv1.Detective](ctx, c, names.Detective, make(map[string]any)))
 }
@@ -557,6 +749,10 @@ func (c *AWSClient) DevOpsGuruClient(ctx context.Context) *devopsguru_sdkv2.Clie
 	return errs.Must(client[*devopsguru_sdkv2.Client](ctx, c, names.DevOpsGuru, make(map[string]any)))
 }
 
+func (c *AWSClient) DevOpsGuruClientForRegion(ctx context.Context, region string) *devopsguru_sdkv2.Client {
+	return errs.Must(clientForRegion[*devopsguru_sdkv2.Client](ctx, c, names.DevOpsGuru, region))
+}
+
 func (c *AWSClient) DeviceFarmConn(ctx context.Context) *devicefarm_sdkv1.DeviceFarm {
 	return errs.Must(conn[*devicefarm_sdkv1.DeviceFarm](ctx, c, names.DeviceFarm, make(map[string]any)))
 }
@@ -573,6 +769,10 @@ func (c *AWSClient) DocDBElasticClient(ctx context.Context) *docdbelastic_sdkv2.
 	return errs.Must(client[*docdbelastic_sdkv2.Client](ctx, c, names.DocDBElastic, make(map[string]any)))
 }
 
+func (c *AWSClient) DocDBElasticClientForRegion(ctx context.Context, region string) *docdbelastic_sdkv2.Client {
+	return errs.Must(clientForRegion[*docdbelastic_sdkv2.Client](ctx, c, names.DocDBElastic, region))
+}
+
 func (c *AWSClient) DynamoDBConn(ctx context.Context) *dynamodb_sdkv1.DynamoDB {
 	return errs.Must(conn[*dynamodb_sdkv1.DynamoDB](ctx, c, names.DynamoDB, make(map[string]any)))
 }
@@ -581,6 +781,10 @@ func (c *AWSClient) DynamoDBClient(ctx context.Context) *dynamodb_sdkv2.Client {
 	return errs.Must(client[*dynamodb_sdkv2.Client](ctx, c, names.DynamoDB, make(map[string]any)))
 }
 
+func (c *AWSClient) DynamoDBClientForRegion(ctx context.Context, region string) *dynamodb_sdkv2.Client {
+	return errs.Must(clientForRegion[*dynamodb_sdkv2.Client](ctx, c, names.DynamoDB, region))
+}
+
 func (c *AWSClient) EC2Conn(ctx context.Context) *ec2_sdkv1.EC2 {
 	return errs.Must(conn[*ec2_sdkv1.EC2](ctx, c, names.EC2, make(map[string]any)))
 }
@@ -589,14 +793,26 @@ func (c *AWSClient) EC2Client(ctx context.Context) *ec2_sdkv2.Client {
 	return errs.Must(client[*ec2_sdkv2.Client](ctx, c, names.EC2, make(map[string]any)))
 }
 
+func (c *AWSClient) EC2ClientForRegion(ctx context.Context, region string) *ec2_sdkv2.Client {
+	return errs.Must(clientForRegion[*ec2_sdkv2.Client](ctx, c, names.EC2, region))
+}
+
 func (c *AWSClient) ECRClient(ctx context.Context) *ecr_sdkv2.Client {
 	return errs.Must(client[*ecr_sdkv2.Client](ctx, c, names.ECR, make(map[string]any)))
 }
 
+func (c *AWSClient) ECRClientForRegion(ctx context.Context, region string) *ecr_sdkv2.Client {
+	return errs.Must(clientForRegion[*ecr_sdkv2.Client](ctx, c, names.ECR, region))
+}
+
 func (c *AWSClient) ECRPublicClient(ctx context.Context) *ecrpublic_sdkv2.Client {
 	return errs.Must(client[*ecrpublic_sdkv2.Client](ctx, c, names.ECRPublic, make(map[string]any)))
 }
 
+func (c *AWSClient) ECRPublicClientForRegion(ctx context.Context, region string) *ecrpublic_sdkv2.Client {
+	return errs.Must(clientForRegion[*ecrpublic_sdkv2.Client](ctx, c, names.ECRPublic, region))
+}
+
 func (c *AWSClient) ECSConn(ctx context.Context) *ecs_sdkv1.ECS {
 	return errs.Must(conn[*ecs_sdkv1.ECS](ctx, c, names.ECS, make(map[string]any)))
 }
@@ -605,6 +821,10 @@ func (c *AWSClient) ECSClient(ctx context.Context) *ecs_sdkv2.Client {
 	return errs.Must(client[*ecs_sdkv2.Client](ctx, c, names.ECS, make(map[string]any)))
 }
 
+func (c *AWSClient) ECSClientForRegion(ctx context.Context, region string) *ecs_sdkv2.Client {
+	return errs.Must(clientForRegion[*ecs_sdkv2.Client](ctx, c, names.ECS, region))
+}
+
 func (c *AWSClient) EFSConn(ctx context.Context) *efs_sdkv1.EFS {
 	return errs.Must(conn[*efs_sdkv1.EFS](ctx, c, names.EFS, make(map[string]any)))
 }
@@ -613,6 +833,10 @@ func (c *AWSClient) EKSClient(ctx context.Context) *eks_sdkv2.Client {
 	return errs.Must(client[*eks_sdkv2.Client](ctx, c, names.EKS, make(map[string]any)))
 }
 
+func (c *AWSClient) EKSClientForRegion(ctx context.Context, region string) *eks_sdkv2.Client {
+	return errs.Must(clientForRegion[*eks_sdkv2.Client](ctx, c, names.EKS, region))
+}
+
 func (c *AWSClient) ELBConn(ctx context.Context) *elb_sdkv1.ELB {
 	return errs.Must(conn[*elb_sdkv1.ELB](ctx, c, names.ELB, make(map[string]any)))
 }
@@ -625,6 +849,10 @@ func (c *AWSClient) ELBV2Client(ctx context.Context) *elasticloadbalancingv2_sdk
 	return errs.Must(client[*elasticloadbalancingv2_sdkv2.Client](ctx, c, names.ELBV2, make(map[string]any)))
 }
 
+func (c *AWSClient) ELBV2ClientForRegion(ctx context.Context, region string) *elasticloadbalancingv2_sdkv2.Client {
+	return errs.Must(clientForRegion[*elasticloadbalancingv2_sdkv2.Client](ctx, c, names.ELBV2, region))
+}
+
 func (c *AWSClient) EMRConn(ctx context.Context) *emr_sdkv1.EMR {
 	return errs.Must(conn[*emr_sdkv1.EMR](ctx, c, names.EMR, make(map[string]any)))
 }
@@ -633,6 +861,10 @@ func (c *AWSClient) EMRClient(ctx context.Context) *emr_sdkv2.Client {
 	return errs.Must(client[*emr_sdkv2.Client](ctx, c, names.EMR, make(map[string]any)))
 }
 
+func (c *AWSClient) EMRClientForRegion(ctx context.Context, region string) *emr_sdkv2.Client {
+	return errs.Must(clientForRegion[*emr_sdkv2.Client](ctx, c, names.EMR, region))
+}
+
 func (c *AWSClient) EMRContainersConn(ctx context.Context) *emrcontainers_sdkv1.EMRContainers {
 	return errs.Must(conn[*emrcontainers_sdkv1.EMRContainers](ctx, c, names.EMRContainers, make(map[string]any)))
 }
@@ -641,6 +873,10 @@ func (c *AWSClient) EMRServerlessClient(ctx context.Context) *emrserverless_sdkv
 	return errs.Must(client[*emrserverless_sdkv2.Client](ctx, c, names.EMRServerless, make(map[string]any)))
 }
 
+func (c *AWSClient) EMRServerlessClientForRegion(ctx context.Context, region string) *emrserverless_sdkv2.Client {
+	return errs.Must(clientForRegion[*emrserverless_sdkv2.Client](ctx, c, names.EMRServerless, region))
+}
+
 func (c *AWSClient) ElastiCacheConn(ctx context.Context) *elasticache_sdkv1.ElastiCache {
 	return errs.Must(conn[*elasticache_sdkv1.ElastiCache](ctx, c, names.ElastiCache, make(map[string]any)))
 }
@@ -649,10 +885,18 @@ func (c *AWSClient) ElastiCacheClient(ctx context.Context) *elasticache_sdkv2.Cl
 	return errs.Must(client[*elasticache_sdkv2.Client](ctx, c, names.ElastiCache, make(map[string]any)))
 }
 
+func (c *AWSClient) ElastiCacheClientForRegion(ctx context.Context, region string) *elasticache_sdkv2.Client {
+	return errs.Must(clientForRegion[*elasticache_sdkv2.Client](ctx, c, names.ElastiCache, region))
+}
+
 func (c *AWSClient) ElasticBeanstalkClient(ctx context.Context) *elasticbeanstalk_sdkv2.Client {
 	return errs.Must(client[*elasticbeanstalk_sdkv2.Client](ctx, c, names.ElasticBeanstalk, make(map[string]any)))
 }
 
+func (c *AWSClient) ElasticBeanstalkClientForRegion(ctx context.Context, region string) *elasticbeanstalk_sdkv2.Client {
+	return errs.Must(clientForRegion[*elasticbeanstalk_sdkv2.Client](ctx, c, names.ElasticBeanstalk, region))
+}
+
 func (c *AWSClient) ElasticTranscoderConn(ctx context.Context) *elastictranscoder_sdkv1.ElasticTranscoder {
 	return errs.Must(conn[*elastictranscoder_sdkv1.ElasticTranscoder](ctx, c, names.ElasticTranscoder, make(map[string]any)))
 }
@@ -669,10 +913,18 @@ func (c *AWSClient) EvidentlyClient(ctx context.Context) *evidently_sdkv2.Client
 	return errs.Must(client[*evidently_sdkv2.Client](ctx, c, names.Evidently, make(map[string]any)))
 }
 
+func (c *AWSClient) EvidentlyClientForRegion(ctx context.Context, region string) *evidently_sdkv2.Client {
+	return errs.Must(clientForRegion[*evidently_sdkv2.Client](ctx, c, names.Evidently, region))
+}
+
 func (c *AWSClient) FISClient(ctx context.Context) *fis_sdkv2.Client {
 	return errs.Must(client[*fis_sdkv2.Client](ctx, c, names.FIS, make(map[string]any)))
 }
 
+func (c *AWSClient) FISClientForRegion(ctx context.Context, region string) *fis_sdkv2.Client {
+	return errs.Must(clientForRegion[*fis_sdkv2.Client](ctx, c, names.FIS, region))
+}
+
 func (c *AWSClient) FMSConn(ctx context.Context) *fms_sdkv1.FMS {
 	return errs.Must(conn[*fms_sdkv1.FMS](ctx, c, names.FMS, make(map[string]any)))
 }
@@ -685,10 +937,18 @@ func (c *AWSClient) FinSpaceClient(ctx context.Context) *finspace_sdkv2.Client {
 	return errs.Must(client[*finspace_sdkv2.Client](ctx, c, names.FinSpace, make(map[string]any)))
 }
 
+func (c *AWSClient) FinSpaceClientForRegion(ctx context.Context, region string) *finspace_sdkv2.Client {
+	return errs.Must(clientForRegion[*finspace_sdkv2.Client](ctx, c, names.FinSpace, region))
+}
+
 func (c *AWSClient) FirehoseClient(ctx context.Context) *firehose_sdkv2.Client {
 	return errs.Must(client[*firehose_sdkv2.Client](ctx, c, names.Firehose, make(map[string]any)))
 }
 
+func (c *AWSClient) FirehoseClientForRegion(ctx context.Context, region string) *firehose_sdkv2.Client {
+	return errs.Must(clientForRegion[*firehose_sdkv2.Client](ctx, c, names.Firehose, region))
+}
+
 func (c *AWSClient) GameLiftConn(ctx context.Context) *gamelift_sdkv1.GameLift {
 	return errs.Must(conn[*gamelift_sdkv1.GameLift](ctx, c, names.GameLift, make(map[string]any)))
 }
@@ -697,6 +957,10 @@ func (c *AWSClient) GlacierClient(ctx context.Context) *glacier_sdkv2.Client {
 	return errs.Must(client[*glacier_sdkv2.Client](ctx, c, names.Glacier, make(map[string]any)))
 }
 
+func (c *AWSClient) GlacierClientForRegion(ctx context.Context, region string) *glacier_sdkv2.Client {
+	return errs.Must(clientForRegion[*glacier_sdkv2.Client](ctx, c, names.Glacier, region))
+}
+
 func (c *AWSClient) GlobalAcceleratorConn(ctx context.Context) *globalaccelerator_sdkv1.GlobalAccelerator {
 	return errs.Must(conn[*globalaccelerator_sdkv1.GlobalAccelerator](ctx, c, names.GlobalAccelerator, make(map[string]any)))
 }
@@ -717,6 +981,10 @@ func (c *AWSClient) GroundStationClient(ctx context.Context) *groundstation_sdkv
 	return errs.Must(client[*groundstation_sdkv2.Client](ctx, c, names.GroundStation, make(map[string]any)))
 }
 
+func (c *AWSClient) GroundStationClientForRegion(ctx context.Context, region string) *groundstation_sdkv2.Client {
+	return errs.Must(clientForRegion[*groundstation_sdkv2.Client](ctx, c, names.GroundStation, region))
+}
+
 func (c *AWSClient) GuardDutyConn(ctx context.Context) *guardduty_sdkv1.GuardDuty {
 	return errs.Must(conn[*guardduty_sdkv1.GuardDuty](ctx, c, names.GuardDuty, make(map[string]any)))
 }
@@ -725,10 +993,18 @@ func (c *AWSClient) HealthLakeClient(ctx context.Context) *healthlake_sdkv2.Clie
 	return errs.Must(client[*healthlake_sdkv2.Client](ctx, c, names.HealthLake, make(map[string]any)))
 }
 
+func (c *AWSClient) HealthLakeClientForRegion(ctx context.Context, region string) *healthlake_sdkv2.Client {
+	return errs.Must(clientForRegion[*healthlake_sdkv2.Client](ctx, c, names.HealthLake, region))
+}
+
 func (c *AWSClient) IAMClient(ctx context.Context) *iam_sdkv2.Client {
 	return errs.Must(client[*iam_sdkv2.Client](ctx, c, names.IAM, make(map[string]any)))
 }
 
+func (c *AWSClient) IAMClientForRegion(ctx context.Context, region string) *iam_sdkv2.Client {
+	return errs.Must(clientForRegion[*iam_sdkv2.Client](ctx, c, names.IAM, region))
+}
+
 func (c *AWSClient) IVSConn(ctx context.Context) *ivs_sdkv1.IVS {
 	return errs.Must(conn[*ivs_sdkv1.IVS](ctx, c, names.IVS, make(map[string]any)))
 }
@@ -737,10 +1013,18 @@ func (c *AWSClient) IVSChatClient(ctx context.Context) *ivschat_sdkv2.Client {
 	return errs.Must(client[*ivschat_sdkv2.Client](ctx, c, names.IVSChat, make(map[string]any)))
 }
 
+func (c *AWSClient) IVSChatClientForRegion(ctx context.Context, region string) *ivschat_sdkv2.Client {
+	return errs.Must(clientForRegion[*ivschat_sdkv2.Client](ctx, c, names.IVSChat, region))
+}
+
 func (c *AWSClient) IdentityStoreClient(ctx context.Context) *identitystore_sdkv2.Client {
 	return errs.Must(client[*identitystore_sdkv2.Client](ctx, c, names.IdentityStore, make(map[string]any)))
 }
 
+func (c *AWSClient) IdentityStoreClientForRegion(ctx context.Context, region string) *identitystore_sdkv2.Client {
+	return errs.Must(clientForRegion[*identitystore_sdkv2.Client](ctx, c, names.IdentityStore, region))
+}
+
 func (c *AWSClient) ImageBuilderConn(ctx context.Context) *imagebuilder_sdkv1.Imagebuilder {
 	return errs.Must(conn[*imagebuilder_sdkv1.Imagebuilder](ctx, c, names.ImageBuilder, make(map[string]any)))
 }
@@ -753,10 +1037,18 @@ func (c *AWSClient) Inspector2Client(ctx context.Context) *inspector2_sdkv2.Clie
 	return errs.Must(client[*inspector2_sdkv2.Client](ctx, c, names.Inspector2, make(map[string]any)))
 }
 
+func (c *AWSClient) Inspector2ClientForRegion(ctx context.Context, region string) *inspector2_sdkv2.Client {
+	return errs.Must(clientForRegion[*inspector2_sdkv2.Client](ctx, c, names.Inspector2, region))
+}
+
 func (c *AWSClient) InternetMonitorClient(ctx context.Context) *internetmonitor_sdkv2.Client {
 	return errs.Must(client[*internetmonitor_sdkv2.Client](ctx, c, names.InternetMonitor, make(map[string]any)))
 }
 
+func (c *AWSClient) InternetMonitorClientForRegion(ctx context.Context, region string) *internetmonitor_sdkv2.Client {
+	return errs.Must(clientForRegion[*internetmonitor_sdkv2.Client](ctx, c, names.InternetMonitor, region))
+}
+
 func (c *AWSClient) IoTConn(ctx context.Context) *iot_sdkv1.IoT {
 	return errs.Must(conn[*iot_sdkv1.IoT](ctx, c, names.IoT, make(map[string]any)))
 }
@@ -777,6 +1069,10 @@ func (c *AWSClient) KafkaClient(ctx context.Context) *kafka_sdkv2.Client {
 	return errs.Must(client[*kafka_sdkv2.Client](ctx, c, names.Kafka, make(map[string]any)))
 }
 
+func (c *AWSClient) KafkaClientForRegion(ctx context.Context, region string) *kafka_sdkv2.Client {
+	return errs.Must(clientForRegion[*kafka_sdkv2.Client](ctx, c, names.Kafka, region))
+}
+
 func (c *AWSClient) KafkaConnectConn(ctx context.Context) *kafkaconnect_sdkv1.KafkaConnect {
 	return errs.Must(conn[*kafkaconnect_sdkv1.KafkaConnect](ctx, c, names.KafkaConnect, make(map[string]any)))
 }
@@ -785,14 +1081,26 @@ func (c *AWSClient) KendraClient(ctx context.Context) *kendra_sdkv2.Client {
 	return errs.Must(client[*kendra_sdkv2.Client](ctx, c, names.Kendra, make(map[string]any)))
 }
 
+func (c *AWSClient) KendraClientForRegion(ctx context.Context, region string) *kendra_sdkv2.Client {
+	return errs.Must(clientForRegion[*kendra_sdkv2.Client](ctx, c, names.Kendra, region))
+}
+
 func (c *AWSClient) KeyspacesClient(ctx context.Context) *keyspaces_sdkv2.Client {
 	return errs.Must(client[*keyspaces_sdkv2.Client](ctx, c, names.Keyspaces, make(map[string]any)))
 }
 
+func (c *AWSClient) KeyspacesClientForRegion(ctx context.Context, region string) *keyspaces_sdkv2.Client {
+	return errs.Must(clientForRegion[*keyspaces_sdkv2.Client](ctx, c, names.Keyspaces, region))
+}
+
 func (c *AWSClient) KinesisClient(ctx context.Context) *kinesis_sdkv2.Client {
 	return errs.Must(client[*kinesis_sdkv2.Client](ctx, c, names.Kinesis, make(map[string]any)))
 }
 
+func (c *AWSClient) KinesisClientForRegion(ctx context.Context, region string) *kinesis_sdkv2.Client {
+	return errs.Must(clientForRegion[*kinesis_sdkv2.Client](ctx, c, names.Kinesis, region))
+}
+
 func (c *AWSClient) KinesisAnalyticsConn(ctx context.Context) *kinesisanalytics_sdkv1.KinesisAnalytics {
 	return errs.Must(conn[*kinesisanalytics_sdkv1.KinesisAnalytics](ctx, c, names.KinesisAnalytics, make(map[string]any)))
 }
@@ -809,6 +1117,10 @@ func (c *AWSClient) LakeFormationClient(ctx context.Context) *lakeformation_sdkv
 	return errs.Must(client[*lakeformation_sdkv2.Client](ctx, c, names.LakeFormation, make(map[string]any)))
 }
 
+func (c *AWSClient) LakeFormationClientForRegion(ctx context.Context, region string) *lakeformation_sdkv2.Client {
+	return errs.Must(clientForRegion[*lakeformation_sdkv2.Client](ctx, c, names.LakeFormation, region))
+}
+
 func (c *AWSClient) LambdaConn(ctx context.Context) *lambda_sdkv1.Lambda {
 	return errs.Must(conn[*lambda_sdkv1.Lambda](ctx, c, names.Lambda, make(map[string]any)))
 }
@@ -817,10 +1129,18 @@ func (c *AWSClient) LambdaClient(ctx context.Context) *lambda_sdkv2.Client {
 	return errs.Must(client[*lambda_sdkv2.Client](ctx, c, names.Lambda, make(map[string]any)))
 }
 
+func (c *AWSClient) LambdaClientForRegion(ctx context.Context, region string) *lambda_sdkv2.Client {
+	return errs.Must(clientForRegion[*lambda_sdkv2.Client](ctx, c, names.Lambda, region))
+}
+
 func (c *AWSClient) LaunchWizardClient(ctx context.Context) *launchwizard_sdkv2.Client {
 	return errs.Must(client[*launchwizard_sdkv2.Client](ctx, c, names.LaunchWizard, make(map[string]any)))
 }
 
+func (c *AWSClient) LaunchWizardClientForRegion(ctx context.Context, region string) *launchwizard_sdkv2.Client {
+	return errs.Must(clientForRegion[*launchwizard_sdkv2.Client](ctx, c, names.LaunchWizard, region))
+}
+
 func (c *AWSClient) LexModelsConn(ctx context.Context) *lexmodelbuildingservice_sdkv1.LexModelBuildingService {
 	return errs.Must(conn[*lexmodelbuildingservice_sdkv1.LexModelBuildingService](ctx, c, names.LexModels, make(map[string]any)))
 }
@@ -829,6 +1149,10 @@ func (c *AWSClient) LexV2ModelsClient(ctx context.Context) *lexmodelsv2_sdkv2.Cl
 	return errs.Must(client[*lexmodelsv2_sdkv2.Client](ctx, c, names.LexV2Models, make(map[string]any)))
 }
 
+func (c *AWSClient) LexV2ModelsClientForRegion(ctx context.Context, region string) *lexmodelsv2_sdkv2.Client {
+	return errs.Must(clientForRegion[*lexmodelsv2_sdkv2.Client](ctx, c, names.LexV2Models, region))
+}
+
 func (c *AWSClient) LicenseManagerConn(ctx context.Context) *licensemanager_sdkv1.LicenseManager {
 	return errs.Must(conn[*licensemanager_sdkv1.LicenseManager](ctx, c, names.LicenseManager, make(map[string]any)))
 }
@@ -837,6 +1161,10 @@ func (c *AWSClient) LightsailClient(ctx context.Context) *lightsail_sdkv2.Client
 	return errs.Must(client[*lightsail_sdkv2.Client](ctx, c, names.Lightsail, make(map[string]any)))
 }
 
+func (c *AWSClient) LightsailClientForRegion(ctx context.Context, region string) *lightsail_sdkv2.Client {
+	return errs.Must(clientForRegion[*lightsail_sdkv2.Client](ctx, c, names.Lightsail, region))
+}
+
 func (c *AWSClient) LocationConn(ctx context.Context) *locationservice_sdkv1.LocationService {
 	return errs.Must(conn[*locationservice_sdkv1.LocationService](ctx, c, names.Location, make(map[string]any)))
 }
@@ -845,22 +1173,42 @@ func (c *AWSClient) LogsClient(ctx context.Context) *cloudwatchlogs_sdkv2.Client
 	return errs.Must(client[*cloudwatchlogs_sdkv2.Client](ctx, c, names.Logs, make(map[string]any)))
 }
 
+func (c *AWSClient) LogsClientForRegion(ctx context.Context, region string) *cloudwatchlogs_sdkv2.Client {
+	return errs.Must(clientForRegion[*cloudwatchlogs_sdkv2.Client](ctx, c, names.Logs, region))
+}
+
 func (c *AWSClient) LookoutMetricsClient(ctx context.Context) *lookoutmetrics_sdkv2.Client {
 	return errs.Must(client[*lookoutmetrics_sdkv2.Client](ctx, c, names.LookoutMetrics, make(map[string]any)))
 }
 
+func (c *AWSClient) LookoutMetricsClientForRegion(ctx context.Context, region string) *lookoutmetrics_sdkv2.Client {
+	return errs.Must(clientForRegion[*lookoutmetrics_sdkv2.Client](ctx, c, names.LookoutMetrics, region))
+}
+
 func (c *AWSClient) M2Client(ctx context.Context) *m2_sdkv2.Client {
 	return errs.Must(client[*m2_sdkv2.Client](ctx, c, names.M2, make(map[string]any)))
 }
 
+func (c *AWSClient) M2ClientForRegion(ctx context.Context, region string) *m2_sdkv2.Client {
+	return errs.Must(clientForRegion[*m2_sdkv2.Client](ctx, c, names.M2, region))
+}
+
 func (c *AWSClient) MQClient(ctx context.Context) *mq_sdkv2.Client {
 	return errs.Must(client[*mq_sdkv2.Client](ctx, c, names.MQ, make(map[string]any)))
 }
 
+func (c *AWSClient) MQClientForRegion(ctx context.Context, region string) *mq_sdkv2.Client {
+	return errs.Must(clientForRegion[*mq_sdkv2.Client](ctx, c, names.MQ, region))
+}
+
 func (c *AWSClient) MWAAClient(ctx context.Context) *mwaa_sdkv2.Client {
 	return errs.Must(client[*mwaa_sdkv2.Client](ctx, c, names.MWAA, make(map[string]any)))
 }
 
+func (c *AWSClient) MWAAClientForRegion(ctx context.Context, region string) *mwaa_sdkv2.Client {
+	return errs.Must(clientForRegion[*mwaa_sdkv2.Client](ctx, c, names.MWAA, region))
+}
+
 func (c *AWSClient) Macie2Conn(ctx context.Context) *macie2_sdkv1.Macie2 {
 	return errs.Must(conn[*macie2_sdkv1.Macie2](ctx, c, names.Macie2, make(map[string]any)))
 }
@@ -869,26 +1217,50 @@ func (c *AWSClient) MediaConnectClient(ctx context.Context) *mediaconnect_sdkv2.
 	return errs.Must(client[*mediaconnect_sdkv2.Client](ctx, c, names.MediaConnect, make(map[string]any)))
 }
 
+func (c *AWSClient) MediaConnectClientForRegion(ctx context.Context, region string) *mediaconnect_sdkv2.Client {
+	return errs.Must(clientForRegion[*mediaconnect_sdkv2.Client](ctx, c, names.MediaConnect, region))
+}
+
 func (c *AWSClient) MediaConvertClient(ctx context.Context) *mediaconvert_sdkv2.Client {
 	return errs.Must(client[*mediaconvert_sdkv2.Client](ctx, c, names.MediaConvert, make(map[string]any)))
 }
 
+func (c *AWSClient) MediaConvertClientForRegion(ctx context.Context, region string) *mediaconvert_sdkv2.Client {
+	return errs.Must(clientForRegion[*mediaconvert_sdkv2.Client](ctx, c, names.MediaConvert, region))
+}
+
 func (c *AWSClient) MediaLiveClient(ctx context.Context) *medialive_sdkv2.Client {
 	return errs.Must(client[*medialive_sdkv2.Client](ctx, c, names.MediaLive, make(map[string]any)))
 }
 
+func (c *AWSClient) MediaLiveClientForRegion(ctx context.Context, region string) *medialive_sdkv2.Client {
+	return errs.Must(clientForRegion[*medialive_sdkv2.Client](ctx, c, names.MediaLive, region))
+}
+
 func (c *AWSClient) MediaPackageClient(ctx context.Context) *mediapackage_sdkv2.Client {
 	return errs.Must(client[*mediapackage_sdkv2.Client](ctx, c, names.MediaPackage, make(map[string]any)))
 }
 
+func (c *AWSClient) MediaPackageClientForRegion(ctx context.Context, region string) *mediapackage_sdkv2.Client {
+	return errs.Must(clientForRegion[*mediapackage_sdkv2.Client](ctx, c, names.MediaPackage, region))
+}
+
 func (c *AWSClient) MediaPackageV2Client(ctx context.Context) *mediapackagev2_sdkv2.Client {
 	return errs.Must(client[*mediapackagev2_sdkv2.Client](ctx, c, names.MediaPackageV2, make(map[string]any)))
 }
 
+func (c *AWSClient) MediaPackageV2ClientForRegion(ctx context.Context, region string) *mediapackagev2_sdkv2.Client {
+	return errs.Must(clientForRegion[*mediapackagev2_sdkv2.Client](ctx, c, names.MediaPackageV2, region))
+}
+
 func (c *AWSClient) MediaStoreClient(ctx context.Context) *mediastore_sdkv2.Client {
 	return errs.Must(client[*mediastore_sdkv2.Client](ctx, c, names.MediaStore, make(map[string]any)))
 }
 
+func (c *AWSClient) MediaStoreClientForRegion(ctx context.Context, region string) *mediastore_sdkv2.Client {
+	return errs.Must(clientForRegion[*mediastore_sdkv2.Client](ctx, c, names.MediaStore, region))
+}
+
 func (c *AWSClient) MemoryDBConn(ctx context.Context) *memorydb_sdkv1.MemoryDB {
 	return errs.Must(conn[*memorydb_sdkv1.MemoryDB](ctx, c, names.MemoryDB, make(map[string]any)))
 }
@@ -909,6 +1281,10 @@ func (c *AWSClient) ObservabilityAccessManagerClient(ctx context.Context) *oam_s
 	return errs.Must(client[*oam_sdkv2.Client](ctx, c, names.ObservabilityAccessManager, make(map[string]any)))
 }
 
+func (c *AWSClient) ObservabilityAccessManagerClientForRegion(ctx context.Context, region string) *oam_sdkv2.Client {
+	return errs.Must(clientForRegion[*oam_sdkv2.Client](ctx, c, names.ObservabilityAccessManager, region))
+}
+
 func (c *AWSClient) OpenSearchConn(ctx context.Context) *opensearchservice_sdkv1.OpenSearchService {
 	return errs.Must(conn[*opensearchservice_sdkv1.OpenSearchService](ctx, c, names.OpenSearch, make(map[string]any)))
 }
@@ -917,10 +1293,18 @@ func (c *AWSClient) OpenSearchIngestionClient(ctx context.Context) *osis_sdkv2.C
 	return errs.Must(client[*osis_sdkv2.Client](ctx, c, names.OpenSearchIngestion, make(map[string]any)))
 }
 
+func (c *AWSClient) OpenSearchIngestionClientForRegion(ctx context.Context, region string) *osis_sdkv2.Client {
+	return errs.Must(clientForRegion[*osis_sdkv2.Client](ctx, c, names.OpenSearchIngestion, region))
+}
+
 func (c *AWSClient) OpenSearchServerlessClient(ctx context.Context) *opensearchserverless_sdkv2.Client {
 	return errs.Must(client[*opensearchserverless_sdkv2.Client](ctx, c, names.OpenSearchServerless, make(map[string]any)))
 }
 
+func (c *AWSClient) OpenSearchServerlessClientForRegion(ctx context.Context, region string) *opensearchserverless_sdkv2.Client {
+	return errs.Must(clientForRegion[*opensearchserverless_sdkv2.Client](ctx, c, names.OpenSearchServerless, region))
+}
+
 func (c *AWSClient) OpsWorksConn(ctx context.Context) *opsworks_sdkv1.OpsWorks {
 	return errs.Must(conn[*opsworks_sdkv1.OpsWorks](ctx, c, names.OpsWorks, make(map[string]any)))
 }
@@ -937,10 +1321,18 @@ func (c *AWSClient) PCAConnectorADClient(ctx context.Context) *pcaconnectorad_sd
 	return errs.Must(client[*pcaconnectorad_sdkv2.Client](ctx, c, names.PCAConnectorAD, make(map[string]any)))
 }
 
+func (c *AWSClient) PCAConnectorADClientForRegion(ctx context.Context, region string) *pcaconnectorad_sdkv2.Client {
+	return errs.Must(clientForRegion[*pcaconnectorad_sdkv2.Client](ctx, c, names.PCAConnectorAD, region))
+}
+
 func (c *AWSClient) PaymentCryptographyClient(ctx context.Context) *paymentcryptography_sdkv2.Client {
 	return errs.Must(client[*paymentcryptography_sdkv2.Client](ctx, c, names.PaymentCryptography, make(map[string]any)))
 }
 
+func (c *AWSClient) PaymentCryptographyClientForRegion(ctx context.Context, region string) *paymentcryptography_sdkv2.Client {
+	return errs.Must(clientForRegion[*paymentcryptography_sdkv2.Client](ctx, c, names.PaymentCryptography, region))
+}
+
 func (c *AWSClient) PinpointConn(ctx context.Context) *pinpoint_sdkv1.Pinpoint {
 	return errs.Must(conn[*pinpoint_sdkv1.Pinpoint](ctx, c, names.Pinpoint, make(map[string]any)))
 }
@@ -949,22 +1341,42 @@ func (c *AWSClient) PipesClient(ctx context.Context) *pipes_sdkv2.Client {
 	return errs.Must(client[*pipes_sdkv2.Client](ctx, c, names.Pipes, make(map[string]any)))
 }
 
+func (c *AWSClient) PipesClientForRegion(ctx context.Context, region string) *pipes_sdkv2.Client {
+	return errs.Must(clientForRegion[*pipes_sdkv2.Client](ctx, c, names.Pipes, region))
+}
+
 func (c *AWSClient) PollyClient(ctx context.Context) *polly_sdkv2.Client {
 	return errs.Must(client[*polly_sdkv2.Client](ctx, c, names.Polly, make(map[string]any)))
 }
 
+func (c *AWSClient) PollyClientForRegion(ctx context.Context, region string) *polly_sdkv2.Client {
+	return errs.Must(clientForRegion[*polly_sdkv2.Client](ctx, c, names.Polly, region))
+}
+
 func (c *AWSClient) PricingClient(ctx context.Context) *pricing_sdkv2.Client {
 	return errs.Must(client[*pricing_sdkv2.Client](ctx, c, names.Pricing, make(map[string]any)))
 }
 
+func (c *AWSClient) PricingClientForRegion(ctx context.Context, region string) *pricing_sdkv2.Client {
+	return errs.Must(clientForRegion[*pricing_sdkv2.Client](ctx, c, names.Pricing, region))
+}
+
 func (c *AWSClient) QBusinessClient(ctx context.Context) *qbusiness_sdkv2.Client {
 	return errs.Must(client[*qbusiness_sdkv2.Client](ctx, c, names.QBusiness, make(map[string]any)))
 }
 
+func (c *AWSClient) QBusinessClientForRegion(ctx context.Context, region string) *qbusiness_sdkv2.Client {
+	return errs.Must(clientForRegion[*qbusiness_sdkv2.Client](ctx, c, names.QBusiness, region))
+}
+
 func (c *AWSClient) QLDBClient(ctx context.Context) *qldb_sdkv2.Client {
 	return errs.Must(client[*qldb_sdkv2.Client](ctx, c, names.QLDB, make(map[string]any)))
 }
 
+func (c *AWSClient) QLDBClientForRegion(ctx context.Context, region string) *qldb_sdkv2.Client {
+	return errs.Must(clientForRegion[*qldb_sdkv2.Client](ctx, c, names.QLDB, region))
+}
+
 func (c *AWSClient) QuickSightConn(ctx context.Context) *quicksight_sdkv1.QuickSight {
 	return errs.Must(conn[*quicksight_sdkv1.QuickSight](ctx, c, names.QuickSight, make(map[string]any)))
 }
@@ -977,6 +1389,10 @@ func (c *AWSClient) RBinClient(ctx context.Context) *rbin_sdkv2.Client {
 	return errs.Must(client[*rbin_sdkv2.Client](ctx, c, names.RBin, make(map[string]any)))
 }
 
+func (c *AWSClient) RBinClientForRegion(ctx context.Context, region string) *rbin_sdkv2.Client {
+	return errs.Must(clientForRegion[*rbin_sdkv2.Client](ctx, c, names.RBin, region))
+}
+
 func (c *AWSClient) RDSConn(ctx context.Context) *rds_sdkv1.RDS {
 	return errs.Must(conn[*rds_sdkv1.RDS](ctx, c, names.RDS, make(map[string]any)))
 }
@@ -985,6 +1401,10 @@ func (c *AWSClient) RDSClient(ctx context.Context) *rds_sdkv2.Client {
 	return errs.Must(client[*rds_sdkv2.Client](ctx, c, names.RDS, make(map[string]any)))
 }
 
+func (c *AWSClient) RDSClientForRegion(ctx context.Context, region string) *rds_sdkv2.Client {
+	return errs.Must(clientForRegion[*rds_sdkv2.Client](ctx, c, names.RDS, region))
+}
+
 func (c *AWSClient) RUMConn(ctx context.Context) *cloudwatchrum_sdkv1.CloudWatchRUM {
 	return errs.Must(conn[*cloudwatchrum_sdkv1.CloudWatchRUM](ctx, c, names.RUM, make(map[string]any)))
 }
@@ -997,10 +1417,18 @@ func (c *AWSClient) RedshiftClient(ctx context.Context) *redshift_sdkv2.Client {
 	return errs.Must(client[*redshift_sdkv2.Client](ctx, c, names.Redshift, make(map[string]any)))
 }
 
+func (c *AWSClient) RedshiftClientForRegion(ctx context.Context, region string) *redshift_sdkv2.Client {
+	return errs.Must(clientForRegion[*redshift_sdkv2.Client](ctx, c, names.Redshift, region))
+}
+
 func (c *AWSClient) RedshiftDataClient(ctx context.Context) *redshiftdata_sdkv2.Client {
 	return errs.Must(client[*redshiftdata_sdkv2.Client](ctx, c, names.RedshiftData, make(map[string]any)))
 }
 
+func (c *AWSClient) RedshiftDataClientForRegion(ctx context.Context, region string) *redshiftdata_sdkv2.Client {
+	return errs.Must(clientForRegion[*redshiftdata_sdkv2.Client](ctx, c, names.RedshiftData, region))
+}
+
 func (c *AWSClient) RedshiftServerlessConn(ctx context.Context) *redshiftserverless_sdkv1.RedshiftServerless {
 	return errs.Must(conn[*redshiftserverless_sdkv1.RedshiftServerless](ctx, c, names.RedshiftServerless, make(map[string]any)))
 }
@@ -1009,26 +1437,50 @@ func (c *AWSClient) RedshiftServerlessClient(ctx context.Context) *redshiftserve
 	return errs.Must(client[*redshiftserverless_sdkv2.Client](ctx, c, names.RedshiftServerless, make(map[string]any)))
 }
 
+func (c *AWSClient) RedshiftServerlessClientForRegion(ctx context.Context, region string) *redshiftserverless_sdkv2.Client {
+	return errs.Must(clientForRegion[*redshiftserverless_sdkv2.Client](ctx, c, names.RedshiftServerless, region))
+}
+
 func (c *AWSClient) RekognitionClient(ctx context.Context) *rekognition_sdkv2.Client {
 	return errs.Must(client[*rekognition_sdkv2.Client](ctx, c, names.Rekognition, make(map[string]any)))
 }
 
+func (c *AWSClient) RekognitionClientForRegion(ctx context.Context, region string) *rekognition_sdkv2.Client {
+	return errs.Must(clientForRegion[*rekognition_sdkv2.Client](ctx, c, names.Rekognition, region))
+}
+
 func (c *AWSClient) ResourceExplorer2Client(ctx context.Context) *resourceexplorer2_sdkv2.Client {
 	return errs.Must(client[*resourceexplorer2_sdkv2.Client](ctx, c, names.ResourceExplorer2, make(map[string]any)))
 }
 
+func (c *AWSClient) ResourceExplorer2ClientForRegion(ctx context.Context, region string) *resourceexplorer2_sdkv2.Client {
+	return errs.Must(clientForRegion[*resourceexplorer2_sdkv2.Client](ctx, c, names.ResourceExplorer2, region))
+}
+
 func (c *AWSClient) ResourceGroupsClient(ctx context.Context) *resourcegroups_sdkv2.Client {
 	return errs.Must(client[*resourcegroups_sdkv2.Client](ctx, c, names.ResourceGroups, make(map[string]any)))
 }
 
+func (c *AWSClient) ResourceGroupsClientForRegion(ctx context.Context, region string) *resourcegroups_sdkv2.Client {
+	return errs.Must(clientForRegion[*resourcegroups_sdkv2.Client](ctx, c, names.ResourceGroups, region))
+}
+
 func (c *AWSClient) ResourceGroupsTaggingAPIClient(ctx context.Context) *resourcegroupstaggingapi_sdkv2.Client {
 	return errs.Must(client[*resourcegroupstaggingapi_sdkv2.Client](ctx, c, names.ResourceGroupsTaggingAPI, make(map[string]any)))
 }
 
+func (c *AWSClient) ResourceGroupsTaggingAPIClientForRegion(ctx context.Context, region string) *resourcegroupstaggingapi_sdkv2.Client {
+	return errs.Must(clientForRegion[*resourcegroupstaggingapi_sdkv2.Client](ctx, c, names.ResourceGroupsTaggingAPI, region))
+}
+
 func (c *AWSClient) RolesAnywhereClient(ctx context.Context) *rolesanywhere_sdkv2.Client {
 	return errs.Must(client[*rolesanywhere_sdkv2.Client](ctx, c, names.RolesAnywhere, make(map[string]any)))
 }
 
+func (c *AWSClient) RolesAnywhereClientForRegion(ctx context.Context, region string) *rolesanywhere_sdkv2.Client {
+	return errs.Must(clientForRegion[*rolesanywhere_sdkv2.Client](ctx, c, names.RolesAnywhere, region))
+}
+
 func (c *AWSClient) Route53Conn(ctx context.Context) *route53_sdkv1.Route53 {
 	return errs.Must(conn[*route53_sdkv1.Route53](ctx, c, names.Route53, make(map[string]any)))
 }
@@ -1037,6 +1489,10 @@ func (c *AWSClient) Route53DomainsClient(ctx context.Context) *route53domains_sd
 	return errs.Must(client[*route53domains_sdkv2.Client](ctx, c, names.Route53Domains, make(map[string]any)))
 }
 
+func (c *AWSClient) Route53DomainsClientForRegion(ctx context.Context, region string) *route53domains_sdkv2.Client {
+	return errs.Must(clientForRegion[*route53domains_sdkv2.Client](ctx, c, names.Route53Domains, region))
+}
+
 func (c *AWSClient) Route53RecoveryControlConfigConn(ctx context.Context) *route53recoverycontrolconfig_sdkv1.Route53RecoveryControlConfig {
 	return errs.Must(conn[*route53recoverycontrolconfig_sdkv1.Route53RecoveryControlConfig](ctx, c, names.Route53RecoveryControlConfig, make(map[string]any)))
 }
@@ -1053,10 +1509,18 @@ func (c *AWSClient) S3Client(ctx context.Context) *s3_sdkv2.Client {
 	return errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, make(map[string]any)))
 }
 
+func (c *AWSClient) S3ClientForRegion(ctx context.Context, region string) *s3_sdkv2.Client {
+	return errs.Must(clientForRegion[*s3_sdkv2.Client](ctx, c, names.S3, region))
+}
+
 func (c *AWSClient) S3ControlClient(ctx context.Context) *s3control_sdkv2.Client {
 	return errs.Must(client[*s3control_sdkv2.Client](ctx, c, names.S3Control, make(map[string]any)))
 }
 
+func (c *AWSClient) S3ControlClientForRegion(ctx context.Context, region string) *s3control_sdkv2.Client {
+	return errs.Must(clientForRegion[*s3control_sdkv2.Client](ctx, c, names.S3Control, region))
+}
+
 func (c *AWSClient) S3OutpostsConn(ctx context.Context) *s3outposts_sdkv1.S3Outposts {
 	return errs.Must(conn[*s3outposts_sdkv1.S3Outposts](ctx, c, names.S3Outposts, make(map[string]any)))
 }
@@ -1069,6 +1533,10 @@ func (c *AWSClient) SESV2Client(ctx context.Context) *sesv2_sdkv2.Client {
 	return errs.Must(client[*sesv2_sdkv2.Client](ctx, c, names.SESV2, make(map[string]any)))
 }
 
+func (c *AWSClient) SESV2ClientForRegion(ctx context.Context, region string) *sesv2_sdkv2.Client {
+	return errs.Must(clientForRegion[*sesv2_sdkv2.Client](ctx, c, names.SESV2, region))
+}
+
 func (c *AWSClient) SFNConn(ctx context.Context) *sfn_sdkv1.SFN {
 	return errs.Must(conn[*sfn_sdkv1.SFN](ctx, c, names.SFN, make(map[string]any)))
 }
@@ -1077,10 +1545,18 @@ func (c *AWSClient) SNSClient(ctx context.Context) *sns_sdkv2.Client {
 	return errs.Must(client[*sns_sdkv2.Client](ctx, c, names.SNS, make(map[string]any)))
 }
 
+func (c *AWSClient) SNSClientForRegion(ctx context.Context, region string) *sns_sdkv2.Client {
+	return errs.Must(clientForRegion[*sns_sdkv2.Client](ctx, c, names.SNS, region))
+}
+
 func (c *AWSClient) SQSClient(ctx context.Context) *sqs_sdkv2.Client {
 	return errs.Must(client[*sqs_sdkv2.Client](ctx, c, names.SQS, make(map[string]any)))
 }
 
+func (c *AWSClient) SQSClientForRegion(ctx context.Context, region string) *sqs_sdkv2.Client {
+	return errs.Must(clientForRegion[*sqs_sdkv2.Client](ctx, c, names.SQS, region))
+}
+
 func (c *AWSClient) SSMConn(ctx context.Context) *ssm_sdkv1.SSM {
 	return errs.Must(conn[*ssm_sdkv1.SSM](ctx, c, names.SSM, make(map[string]any)))
 }
@@ -1089,34 +1565,66 @@ func (c *AWSClient) SSMClient(ctx context.Context) *ssm_sdkv2.Client {
 	return errs.Must(client[*ssm_sdkv2.Client](ctx, c, names.SSM, make(map[string]any)))
 }
 
+func (c *AWSClient) SSMClientForRegion(ctx context.Context, region string) *ssm_sdkv2.Client {
+	return errs.Must(clientForRegion[*ssm_sdkv2.Client](ctx, c, names.SSM, region))
+}
+
 func (c *AWSClient) SSMContactsClient(ctx context.Context) *ssmcontacts_sdkv2.Client {
 	return errs.Must(client[*ssmcontacts_sdkv2.Client](ctx, c, names.SSMContacts, make(map[string]any)))
 }
 
+func (c *AWSClient) SSMContactsClientForRegion(ctx context.Context, region string) *ssmcontacts_sdkv2.Client {
+	return errs.Must(clientForRegion[*ssmcontacts_sdkv2.Client](ctx, c, names.SSMContacts, region))
+}
+
 func (c *AWSClient) SSMIncidentsClient(ctx context.Context) *ssmincidents_sdkv2.Client {
 	return errs.Must(client[*ssmincidents_sdkv2.Client](ctx, c, names.SSMIncidents, make(map[string]any)))
 }
 
+func (c *AWSClient) SSMIncidentsClientForRegion(ctx context.Context, region string) *ssmincidents_sdkv2.Client {
+	return errs.Must(clientForRegion[*ssmincidents_sdkv2.Client](ctx, c, names.SSMIncidents, region))
+}
+
 func (c *AWSClient) SSMSAPClient(ctx context.Context) *ssmsap_sdkv2.Client {
 	return errs.Must(client[*ssmsap_sdkv2.Client](ctx, c, names.SSMSAP, make(map[string]any)))
 }
 
+func (c *AWSClient) SSMSAPClientForRegion(ctx context.Context, region string) *ssmsap_sdkv2.Client {
+	return errs.Must(clientForRegion[*ssmsap_sdkv2.Client](ctx, c, names.SSMSAP, region))
+}
+
 func (c *AWSClient) SSOClient(ctx context.Context) *sso_sdkv2.Client {
 	return errs.Must(client[*sso_sdkv2.Client](ctx, c, names.SSO, make(map[string]any)))
 }
 
+func (c *AWSClient) SSOClientForRegion(ctx context.Context, region string) *sso_sdkv2.Client {
+	return errs.Must(clientForRegion[*sso_sdkv2.Client](ctx, c, names.SSO, region))
+}
+
 func (c *AWSClient) SSOAdminClient(ctx context.Context) *ssoadmin_sdkv2.Client {
 	return errs.Must(client[*ssoadmin_sdkv2.Client](ctx, c, names.SSOAdmin, make(map[string]any)))
 }
 
+func (c *AWSClient) SSOAdminClientForRegion(ctx context.Context, region string) *ssoadmin_sdkv2.Client {
+	return errs.Must(clientForRegion[*ssoadmin_sdkv2.Client](ctx, c, names.SSOAdmin, region))
+}
+
 func (c *AWSClient) STSClient(ctx context.Context) *sts_sdkv2.Client {
 	return errs.Must(client[*sts_sdkv2.Client](ctx, c, names.STS, make(map[string]any)))
 }
 
+func (c *AWSClient) STSClientForRegion(ctx context.Context, region string) *sts_sdkv2.Client {
+	return errs.Must(clientForRegion[*sts_sdkv2.Client](ctx, c, names.STS, region))
+}
+
 func (c *AWSClient) SWFClient(ctx context.Context) *swf_sdkv2.Client {
 	return errs.Must(client[*swf_sdkv2.Client](ctx, c, names.SWF, make(map[string]any)))
 }
 
+func (c *AWSClient) SWFClientForRegion(ctx context.Context, region string) *swf_sdkv2.Client {
+	return errs.Must(clientForRegion[*swf_sdkv2.Client](ctx, c, names.SWF, region))
+}
+
 func (c *AWSClient) SageMakerConn(ctx context.Context) *sagemaker_sdkv1.SageMaker {
 	return errs.Must(conn[*sagemaker_sdkv1.SageMaker](ctx, c, names.SageMaker, make(map[string]any)))
 }
@@ -1125,6 +1633,10 @@ func (c *AWSClient) SchedulerClient(ctx context.Context) *scheduler_sdkv2.Client
 	return errs.Must(client[*scheduler_sdkv2.Client](ctx, c, names.Scheduler, make(map[string]any)))
 }
 
+func (c *AWSClient) SchedulerClientForRegion(ctx context.Context, region string) *scheduler_sdkv2.Client {
+	return errs.Must(clientForRegion[*scheduler_sdkv2.Client](ctx, c, names.Scheduler, region))
+}
+
 func (c *AWSClient) SchemasConn(ctx context.Context) *schemas_sdkv1.Schemas {
 	return errs.Must(conn[*schemas_sdkv1.Schemas](ctx, c, names.Schemas, make(map[string]any)))
 }
@@ -1133,14 +1645,26 @@ func (c *AWSClient) SecretsManagerClient(ctx context.Context) *secretsmanager_sd
 	return errs.Must(client[*secretsmanager_sdkv2.Client](ctx, c, names.SecretsManager, make(map[string]any)))
 }
 
+func (c *AWSClient) SecretsManagerClientForRegion(ctx context.Context, region string) *secretsmanager_sdkv2.Client {
+	return errs.Must(clientForRegion[*secretsmanager_sdkv2.Client](ctx, c, names.SecretsManager, region))
+}
+
 func (c *AWSClient) SecurityHubClient(ctx context.Context) *securityhub_sdkv2.Client {
 	return errs.Must(client[*securityhub_sdkv2.Client](ctx, c, names.SecurityHub, make(map[string]any)))
 }
 
+func (c *AWSClient) SecurityHubClientForRegion(ctx context.Context, region string) *securityhub_sdkv2.Client {
+	return errs.Must(clientForRegion[*securityhub_sdkv2.Client](ctx, c, names.SecurityHub, region))
+}
+
 func (c *AWSClient) SecurityLakeClient(ctx context.Context) *securitylake_sdkv2.Client {
 	return errs.Must(client[*securitylake_sdkv2.Client](ctx, c, names.SecurityLake, make(map[string]any)))
 }
 
+func (c *AWSClient) SecurityLakeClientForRegion(ctx context.Context, region string) *securitylake_sdkv2.Client {
+	return errs.Must(clientForRegion[*securitylake_sdkv2.Client](ctx, c, names.SecurityLake, region))
+}
+
 func (c *AWSClient) ServerlessRepoConn(ctx context.Context) *serverlessapplicationrepository_sdkv1.ServerlessApplicationRepository {
 	return errs.Must(conn[*serverlessapplicationrepository_sdkv1.ServerlessApplicationRepository](ctx, c, names.ServerlessRepo, make(map[string]any)))
 }
@@ -1153,6 +1677,10 @@ func (c *AWSClient) ServiceCatalogAppRegistryClient(ctx context.Context) *servic
 	return errs.Must(client[*servicecatalogappregistry_sdkv2.Client](ctx, c, names.ServiceCatalogAppRegistry, make(map[string]any)))
 }
 
+func (c *AWSClient) ServiceCatalogAppRegistryClientForRegion(ctx context.Context, region string) *servicecatalogappregistry_sdkv2.Client {
+	return errs.Must(clientForRegion[*servicecatalogappregistry_sdkv2.Client](ctx, c, names.ServiceCatalogAppRegistry, region))
+}
+
 func (c *AWSClient) ServiceDiscoveryConn(ctx context.Context) *servicediscovery_sdkv1.ServiceDiscovery {
 	return errs.Must(conn[*servicediscovery_sdkv1.ServiceDiscovery](ctx, c, names.ServiceDiscovery, make(map[string]any)))
 }
@@ -1161,14 +1689,26 @@ func (c *AWSClient) ServiceQuotasClient(ctx context.Context) *servicequotas_sdkv
 	return errs.Must(client[*servicequotas_sdkv2.Client](ctx, c, names.ServiceQuotas, make(map[string]any)))
 }
 
+func (c *AWSClient) ServiceQuotasClientForRegion(ctx context.Context, region string) *servicequotas_sdkv2.Client {
+	return errs.Must(clientForRegion[*servicequotas_sdkv2.Client](ctx, c, names.ServiceQuotas, region))
+}
+
 func (c *AWSClient) ShieldClient(ctx context.Context) *shield_sdkv2.Client {
 	return errs.Must(client[*shield_sdkv2.Client](ctx, c, names.Shield, make(map[string]any)))
 }
 
+func (c *AWSClient) ShieldClientForRegion(ctx context.Context, region string) *shield_sdkv2.Client {
+	return errs.Must(clientForRegion[*shield_sdkv2.Client](ctx, c, names.Shield, region))
+}
+
 func (c *AWSClient) SignerClient(ctx context.Context) *signer_sdkv2.Client {
 	return errs.Must(client[*signer_sdkv2.Client](ctx, c, names.Signer, make(map[string]any)))
 }
 
+func (c *AWSClient) SignerClientForRegion(ctx context.Context, region string) *signer_sdkv2.Client {
+	return errs.Must(clientForRegion[*signer_sdkv2.Client](ctx, c, names.Signer, region))
+}
+
 func (c *AWSClient) SimpleDBConn(ctx context.Context) *simpledb_sdkv1.SimpleDB {
 	return errs.Must(conn[*simpledb_sdkv1.SimpleDB](ctx, c, names.SimpleDB, make(map[string]any)))
 }
@@ -1181,14 +1721,26 @@ func (c *AWSClient) SyntheticsClient(ctx context.Context) *synthetics_sdkv2.Clie
 	return errs.Must(client[*synthetics_sdkv2.Client](ctx, c, names.Synthetics, make(map[string]any)))
 }
 
+func (c *AWSClient) SyntheticsClientForRegion(ctx context.Context, region string) *synthetics_sdkv2.Client {
+	return errs.Must(clientForRegion[*synthetics_sdkv2.Client](ctx, c, names.Synthetics, region))
+}
+
 func (c *AWSClient) TimestreamWriteClient(ctx context.Context) *timestreamwrite_sdkv2.Client {
 	return errs.Must(client[*timestreamwrite_sdkv2.Client](ctx, c, names.TimestreamWrite, make(map[string]any)))
 }
 
+func (c *AWSClient) TimestreamWriteClientForRegion(ctx context.Context, region string) *timestreamwrite_sdkv2.Client {
+	return errs.Must(clientForRegion[*timestreamwrite_sdkv2.Client](ctx, c, names.TimestreamWrite, region))
+}
+
 func (c *AWSClient) TranscribeClient(ctx context.Context) *transcribe_sdkv2.Client {
 	return errs.Must(client[*transcribe_sdkv2.Client](ctx, c, names.Transcribe, make(map[string]any)))
 }
 
+func (c *AWSClient) TranscribeClientForRegion(ctx context.Context, region string) *transcribe_sdkv2.Client {
+	return errs.Must(clientForRegion[*transcribe_sdkv2.Client](ctx, c, names.Transcribe, region))
+}
+
 func (c *AWSClient) TransferConn(ctx context.Context) *transfer_sdkv1.Transfer {
 	return errs.Must(conn[*transfer_sdkv1.Transfer](ctx, c, names.Transfer, make(map[string]any)))
 }
@@ -1197,14 +1749,26 @@ func (c *AWSClient) TransferClient(ctx context.Context) *transfer_sdkv2.Client {
 	return errs.Must(client[*transfer_sdkv2.Client](ctx, c, names.Transfer, make(map[string]any)))
 }
 
+func (c *AWSClient) TransferClientForRegion(ctx context.Context, region string) *transfer_sdkv2.Client {
+	return errs.Must(clientForRegion[*transfer_sdkv2.Client](ctx, c, names.Transfer, region))
+}
+
 func (c *AWSClient) VPCLatticeClient(ctx context.Context) *vpclattice_sdkv2.Client {
 	return errs.Must(client[*vpclattice_sdkv2.Client](ctx, c, names.VPCLattice, make(map[string]any)))
 }
 
+func (c *AWSClient) VPCLatticeClientForRegion(ctx context.Context, region string) *vpclattice_sdkv2.Client {
+	return errs.Must(clientForRegion[*vpclattice_sdkv2.Client](ctx, c, names.VPCLattice, region))
+}
+
 func (c *AWSClient) VerifiedPermissionsClient(ctx context.Context) *verifiedpermissions_sdkv2.Client {
 	return errs.Must(client[*verifiedpermissions_sdkv2.Client](ctx, c, names.VerifiedPermissions, make(map[string]any)))
 }
 
+func (c *AWSClient) VerifiedPermissionsClientForRegion(ctx context.Context, region string) *verifiedpermissions_sdkv2.Client {
+	return errs.Must(clientForRegion[*verifiedpermissions_sdkv2.Client](ctx, c, names.VerifiedPermissions, region))
+}
+
 func (c *AWSClient) WAFConn(ctx context.Context) *waf_sdkv1.WAF {
 	return errs.Must(conn[*waf_sdkv1.WAF](ctx, c, names.WAF, make(map[string]any)))
 }
@@ -1221,6 +1785,10 @@ func (c *AWSClient) WellArchitectedClient(ctx context.Context) *wellarchitected_
 	return errs.Must(client[*wellarchitected_sdkv2.Client](ctx, c, names.WellArchitected, make(map[string]any)))
 }
 
+func (c *AWSClient) WellArchitectedClientForRegion(ctx context.Context, region string) *wellarchitected_sdkv2.Client {
+	return errs.Must(clientForRegion[*wellarchitected_sdkv2.Client](ctx, c, names.WellArchitected, region))
+}
+
 func (c *AWSClient) WorkLinkConn(ctx context.Context) *worklink_sdkv1.WorkLink {
 	return errs.Must(conn[*worklink_sdkv1.WorkLink](ctx, c, names.WorkLink, make(map[string]any)))
 }
@@ -1229,6 +1797,14 @@ func (c *AWSClient) WorkSpacesClient(ctx context.Context) *workspaces_sdkv2.Clie
 	return errs.Must(client[*workspaces_sdkv2.Client](ctx, c, names.WorkSpaces, make(map[string]any)))
 }
 
+func (c *AWSClient) WorkSpacesClientForRegion(ctx context.Context, region string) *workspaces_sdkv2.Client {
+	return errs.Must(clientForRegion[*workspaces_sdkv2.Client](ctx, c, names.WorkSpaces, region))
+}
+
 func (c *AWSClient) XRayClient(ctx context.Context) *xray_sdkv2.Client {
 	return errs.Must(client[*xray_sdkv2.Client](ctx, c, names.XRay, make(map[string]any)))
 }
+
+func (c *AWSClient) XRayClientForRegion(ctx context.Context, region string) *xray_sdkv2.Client {
+	return errs.Must(clientForRegion[*xray_sdkv2.Client](ctx, c, names.XRay, region))
+}
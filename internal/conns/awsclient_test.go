@@ -5,7 +5,12 @@ package conns
 
 import (
 	"context"
+	"net/http"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -93,3 +98,95 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+type testRegionalClient struct {
+	endpoint   string
+	httpClient aws_sdkv2.HTTPClient
+	region     string
+}
+
+type testServicePackage struct{}
+
+func (p *testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p *testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (p *testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (p *testServicePackage) NewClient(_ context.Context, config map[string]any) (*testRegionalClient, error) {
+	cfg := config["aws_sdkv2_config"].(*aws_sdkv2.Config)
+
+	return &testRegionalClient{
+		endpoint:   config["endpoint"].(string),
+		httpClient: cfg.HTTPClient,
+		region:     cfg.Region,
+	}, nil
+}
+
+func TestClientForRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	httpClient := &http.Client{}
+	c := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+		ServicePackages: map[string]ServicePackage{
+			"test": &testServicePackage{},
+		},
+		awsConfig: &aws_sdkv2.Config{
+			HTTPClient: httpClient,
+			Region:     "us-west-2", //lintignore:AWSAT003
+		},
+		clients: make(map[string]any),
+		endpoints: map[string]string{
+			"test": "https://test.example.com",
+		},
+	}
+
+	defaultClient, err := clientForRegion[*testRegionalClient](ctx, c, "test", c.Region)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := defaultClient, errs.Must(client[*testRegionalClient](ctx, c, "test", make(map[string]any))); got != want {
+		t.Errorf("default region client = %p, want default client %p", got, want)
+	}
+
+	regionalClient, err := clientForRegion[*testRegionalClient](ctx, c, "test", "us-east-1") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := regionalClient.region, "us-east-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("region = %s, want %s", got, want)
+	}
+	if got, want := regionalClient.endpoint, "https://test.example.com"; got != want {
+		t.Errorf("endpoint = %s, want %s", got, want)
+	}
+	if got, want := regionalClient.httpClient, aws_sdkv2.HTTPClient(httpClient); got != want {
+		t.Errorf("HTTP client = %v, want %v", got, want)
+	}
+	if got, want := c.awsConfig.Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("default configuration region = %s, want %s", got, want)
+	}
+
+	cachedClient, err := clientForRegion[*testRegionalClient](ctx, c, "test", "us-east-1") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cachedClient, regionalClient; got != want {
+		t.Errorf("cached client = %p, want %p", got, want)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
@@ -28,7 +29,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -44,8 +45,11 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimiterOptions             []ratelimit.OptionsFunc // Options for each service's rate limiters.
+	RateLimits                     map[string][]RateLimit
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
+	RetryPolicies                  map[string]RetryPolicy
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
@@ -56,6 +60,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -67,6 +72,10 @@ type Config struct {
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	if diags = c.validateAssumeRoleChain(); diags.HasError() {
+		return nil, diags
+	}
+
 	ctx, logger := logging.NewTfLogger(ctx)
 
 	awsbaseConfig := awsbase.Config{
@@ -108,8 +117,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first role in the chain is assumed using the base credentials.
+	// Any subsequent roles are assumed in order once the AWS configuration has been loaded.
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = &c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -142,9 +153,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
+		summary := d.Summary()
+		if awsbase.IsCannotAssumeRoleError(d) && len(c.AssumeRole) > 1 {
+			summary = fmt.Sprintf("%s (assume_role 1 of %d)", summary, len(c.AssumeRole))
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: baseSeverityToSdkSeverity(d.Severity()),
-			Summary:  d.Summary(),
+			Summary:  summary,
 			Detail:   d.Detail(),
 		})
 	}
@@ -153,6 +168,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(c.AssumeRole) > 1 {
+		diags = append(diags, c.assumeRoleChain(ctx, &cfg)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -218,10 +241,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.rateLimiters = make(map[string]*serviceRateLimiters, len(c.RateLimits))
+	for k, v := range c.RateLimits {
+		client.rateLimiters[k] = newServiceRateLimiters(v, c.RateLimiterOptions...)
+	}
+	client.retryPolicies = c.RetryPolicies
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
+	// Must be called once the client can make AWS API calls.
+	client.TagPolicyConfig, err = tagPolicyConfig(ctx, client, c.TagPolicyConfig)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	return client, diags
 }
 
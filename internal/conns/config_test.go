@@ -508,3 +508,175 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAssumeRoleChain(t *testing.T) {
+	const (
+		hubRoleARN      = "arn:aws:iam::111111111111:role/hub"
+		vendingRoleARN  = "arn:aws:iam::222222222222:role/vending"
+		workloadRoleARN = "arn:aws:iam::333333333333:role/workload"
+		sessionName     = "chained"
+	)
+
+	cases := map[string]struct {
+		assumeRoles   []any
+		endpoints     []*servicemocks.MockEndpoint
+		expectedDiags diag.Diagnostics
+	}{
+		"single role": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     hubRoleARN,
+					"session_name": sessionName,
+				},
+			},
+			endpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleArn":         hubRoleARN,
+					"RoleSessionName": sessionName,
+				}),
+			},
+		},
+
+		"chained roles": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     hubRoleARN,
+					"session_name": sessionName,
+				},
+				map[string]any{
+					"role_arn":     vendingRoleARN,
+					"session_name": sessionName,
+					"external_id":  "vending",
+				},
+				map[string]any{
+					"role_arn":     workloadRoleARN,
+					"session_name": sessionName,
+				},
+			},
+			endpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleArn":         hubRoleARN,
+					"RoleSessionName": sessionName,
+				}),
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"ExternalId":      "vending",
+					"RoleArn":         vendingRoleARN,
+					"RoleSessionName": sessionName,
+				}),
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleArn":         workloadRoleARN,
+					"RoleSessionName": sessionName,
+				}),
+			},
+		},
+
+		"chained role fails": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     hubRoleARN,
+					"session_name": sessionName,
+				},
+				map[string]any{
+					"role_arn":     vendingRoleARN,
+					"session_name": sessionName,
+				},
+			},
+			endpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleArn":         hubRoleARN,
+					"RoleSessionName": sessionName,
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Cannot assume IAM Role (assume_role 2 of 2)",
+				},
+			},
+		},
+
+		"first chained role missing role_arn": {
+			assumeRoles: []any{
+				map[string]any{
+					"session_name": sessionName,
+				},
+				map[string]any{
+					"role_arn":     vendingRoleARN,
+					"session_name": sessionName,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Cannot assume IAM Role (assume_role 1 of 2)",
+				},
+			},
+		},
+
+		"subsequent chained role missing role_arn": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     hubRoleARN,
+					"session_name": sessionName,
+				},
+				map[string]any{
+					"session_name": sessionName,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Cannot assume IAM Role (assume_role 2 of 2)",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			ts := servicemocks.MockAwsApiServer("STS", tc.endpoints)
+			defer ts.Close()
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"region":                      "us-west-2",
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+				"max_retries":                 1,
+				"assume_role":                 tc.assumeRoles,
+				"endpoints": []any{
+					map[string]any{
+						"sts": ts.URL,
+					},
+				},
+			}
+
+			p, err := provider.New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if tc.expectedDiags.HasError() {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+				if got, want := diags[0].Summary, tc.expectedDiags[0].Summary; got != want {
+					t.Errorf("unexpected diagnostic summary: got %q, want %q", got, want)
+				}
+				return
+			}
+
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					t.Errorf("unexpected error diagnostic: %s: %s", d.Summary, d.Detail)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+)
+
+const (
+	rateLimiterMiddlewareID = "tf_aws.RateLimiter"
+	signingMiddlewareID     = "Signing" // The ID of the AWS SDK for Go v2 request signing middleware.
+)
+
+// RateLimit configures client-side rate limiting of a single service's API requests.
+// If Operations is empty the limit applies to all of the service's operations,
+// otherwise the listed operations share the limit.
+type RateLimit struct {
+	Burst             int
+	Operations        []string
+	RequestsPerSecond float64
+}
+
+// serviceRateLimiters holds the rate limiters that apply to a single service's API requests.
+// The limiters are shared by all of the service's API clients.
+type serviceRateLimiters struct {
+	operations map[string]*ratelimit.Limiter // Keyed by API operation name.
+	service    *ratelimit.Limiter
+}
+
+// newServiceRateLimiters returns the rate limiters for the specified service's rate limits.
+// Rate limits are expected to have been validated: at most one applies to all of the service's operations
+// and no operation appears in more than one rate limit.
+func newServiceRateLimiters(rateLimits []RateLimit, optFns ...ratelimit.OptionsFunc) *serviceRateLimiters {
+	limiters := &serviceRateLimiters{
+		operations: make(map[string]*ratelimit.Limiter),
+	}
+
+	for _, v := range rateLimits {
+		limiter := ratelimit.New(v.RequestsPerSecond, v.Burst, optFns...)
+
+		if len(v.Operations) == 0 {
+			limiters.service = limiter
+			continue
+		}
+
+		for _, operation := range v.Operations {
+			limiters.operations[operation] = limiter
+		}
+	}
+
+	return limiters
+}
+
+// wait blocks until all rate limiters that apply to the specified API operation allow the request to proceed.
+func (l *serviceRateLimiters) wait(ctx context.Context, operation string) error {
+	if v, ok := l.operations[operation]; ok {
+		if err := v.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if l.service != nil {
+		if err := l.service.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// awsConfig returns a copy of the specified AWS SDK for Go v2 configuration with rate limiting middleware added.
+func (l *serviceRateLimiters) awsConfig(cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	cfg2 := cfg.Copy()
+	cfg2.APIOptions = make([]func(*middleware.Stack) error, 0, len(cfg.APIOptions)+1)
+	cfg2.APIOptions = append(cfg2.APIOptions, cfg.APIOptions...)
+	cfg2.APIOptions = append(cfg2.APIOptions, l.addMiddleware)
+
+	return &cfg2
+}
+
+// addMiddleware adds rate limiting middleware to the specified AWS SDK for Go v2 middleware stack.
+// The middleware runs after the retry middleware so that each attempt is rate limited,
+// and immediately before the request is signed so that requests don't wait in the queue with a signature that may expire.
+func (l *serviceRateLimiters) addMiddleware(stack *middleware.Stack) error {
+	mw := middleware.FinalizeMiddlewareFunc(
+		rateLimiterMiddlewareID,
+		func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if err := l.wait(ctx, awsmiddleware.GetOperationName(ctx)); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleFinalize(ctx, in)
+		},
+	)
+
+	// Every AWS SDK for Go v2 API client signs requests, but a stack without a signing step is still rate limited.
+	if _, ok := stack.Finalize.Get(signingMiddlewareID); !ok {
+		return stack.Finalize.Add(mw, middleware.After)
+	}
+
+	return stack.Finalize.Insert(mw, signingMiddlewareID, middleware.Before)
+}
+
+// session returns a copy of the specified AWS SDK for Go v1 session with a rate limiting handler added.
+// The handler runs before each attempt's request is signed so that each attempt is rate limited.
+func (l *serviceRateLimiters) session(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	sess2 := sess.Copy()
+	sess2.Handlers.Sign.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: rateLimiterMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			var operation string
+			if r.Operation != nil {
+				operation = r.Operation.Name
+			}
+
+			if err := l.wait(r.Context(), operation); err != nil {
+				r.Error = err
+			}
+		},
+	})
+
+	return sess2
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+)
+
+// testRateLimitClock is a stopped clock, so that rate limiter buckets aren't refilled during tests.
+func testRateLimitClock() time.Time {
+	return time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestServiceRateLimitersMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                     string
+		operation                string
+		expectedOperationDelay   time.Duration
+		expectedServiceDelay     time.Duration
+		expectedOtherOperationOK bool
+	}{
+		{
+			name:                   "limited operation",
+			operation:              "ChangeResourceRecordSets",
+			expectedOperationDelay: 1 * time.Second,
+			expectedServiceDelay:   500 * time.Millisecond,
+		},
+		{
+			name:                   "other operation",
+			operation:              "ListHostedZones",
+			expectedOperationDelay: 0,
+			expectedServiceDelay:   500 * time.Millisecond,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiters := newServiceRateLimiters([]RateLimit{
+				{
+					Burst:             1,
+					RequestsPerSecond: 2,
+				},
+				{
+					Burst:             1,
+					Operations:        []string{"ChangeResourceRecordSets", "ChangeTagsForResource"},
+					RequestsPerSecond: 1,
+				},
+			}, ratelimit.WithClock(testRateLimitClock))
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{OperationName: testCase.operation}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := limiters.addMiddleware(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			var calls int
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+				calls++
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+
+			if _, _, err := handler.Handle(context.Background(), struct{}{}); err != nil {
+				t.Fatalf("Handle: %s", err)
+			}
+
+			if got, want := calls, 1; got != want {
+				t.Errorf("calls = %d, want %d", got, want)
+			}
+
+			// The request consumed a token from each applicable limiter.
+			if got, want := limiters.operations["ChangeResourceRecordSets"].Reserve(), testCase.expectedOperationDelay; got != want {
+				t.Errorf("operation limiter Reserve() = %s, want %s", got, want)
+			}
+			if got, want := limiters.service.Reserve(), testCase.expectedServiceDelay; got != want {
+				t.Errorf("service limiter Reserve() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestServiceRateLimitersMiddlewareOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiters := newServiceRateLimiters([]RateLimit{
+		{
+			Burst:             1,
+			RequestsPerSecond: 1,
+		},
+	}, ratelimit.WithClock(testRateLimitClock))
+
+	cfg := aws_sdkv2.Config{
+		Credentials: aws_sdkv2.CredentialsProviderFunc(func(ctx context.Context) (aws_sdkv2.Credentials, error) {
+			return aws_sdkv2.Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+		}),
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body: io.NopCloser(strings.NewReader(`<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`)),
+			}, nil
+		}),
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	var ids []string
+	client := sts.NewFromConfig(*limiters.awsConfig(&cfg), func(o *sts.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			ids = stack.Finalize.List()
+			return nil
+		})
+	})
+
+	if _, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	index := func(id string) int {
+		return slices.Index(ids, id)
+	}
+
+	// Each attempt is rate limited, and is signed only once it has been allowed to proceed.
+	if got, retry, signing := index(rateLimiterMiddlewareID), index("Retry"), index(signingMiddlewareID); got == -1 || retry == -1 || signing == -1 || got < retry || got > signing {
+		t.Errorf("finalize middleware = %v, want %s between Retry and %s", ids, rateLimiterMiddlewareID, signingMiddlewareID)
+	}
+	if got, want := index(rateLimiterMiddlewareID), index(signingMiddlewareID)-1; got != want {
+		t.Errorf("%s index = %d, want %d", rateLimiterMiddlewareID, got, want)
+	}
+
+	// The request consumed the only token in the bucket, which isn't refilled while the clock is stopped.
+	if got, want := limiters.service.Reserve(), 1*time.Second; got != want {
+		t.Errorf("service limiter Reserve() = %s, want %s", got, want)
+	}
+}
+
+func TestServiceRateLimitersSession(t *testing.T) {
+	t.Parallel()
+
+	sess, err := session_sdkv1.NewSession(aws_sdkv1.NewConfig().WithRegion("us-west-2")) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limiters := newServiceRateLimiters([]RateLimit{
+		{
+			Burst:             1,
+			RequestsPerSecond: 1,
+		},
+	})
+
+	got := limiters.session(sess)
+
+	if got, want := got.Handlers.Sign.Len(), sess.Handlers.Sign.Len()+1; got != want {
+		t.Errorf("policy Sign handlers = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"math/rand"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	client_sdkv1 "github.com/aws/aws-sdk-go/aws/client"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+)
+
+// RetryPolicy overrides the provider-level retry configuration for a single service.
+// Zero values leave the corresponding provider-level setting unchanged.
+type RetryPolicy struct {
+	MaxRetries          int
+	MinBackoff          time.Duration
+	MaxBackoff          time.Duration
+	RetryableErrorCodes []string
+}
+
+const (
+	retryPolicyDefaultMinBackoff = 1 * time.Second
+	retryPolicyDefaultMaxBackoff = retry_sdkv2.DefaultMaxBackoff
+)
+
+func (p *RetryPolicy) minBackoff() time.Duration {
+	if p.MinBackoff > 0 {
+		return p.MinBackoff
+	}
+	return retryPolicyDefaultMinBackoff
+}
+
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff > 0 {
+		return p.MaxBackoff
+	}
+	return retryPolicyDefaultMaxBackoff
+}
+
+func (p *RetryPolicy) hasBackoff() bool {
+	return p.MinBackoff > 0 || p.MaxBackoff > 0
+}
+
+// awsConfig returns a copy of the specified AWS SDK for Go v2 configuration with the retry policy applied.
+func (p *RetryPolicy) awsConfig(cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	cfg2 := cfg.Copy()
+	newRetryer := cfg.Retryer
+
+	cfg2.Retryer = func() aws_sdkv2.Retryer {
+		var r aws_sdkv2.Retryer
+		if newRetryer != nil {
+			r = newRetryer()
+		} else {
+			r = retry_sdkv2.NewStandard()
+		}
+
+		return p.retryer(r)
+	}
+
+	return &cfg2
+}
+
+// retryer wraps the specified AWS SDK for Go v2 Retryer with the retry policy.
+// The returned value always implements aws.RetryerV2.
+func (p *RetryPolicy) retryer(r aws_sdkv2.Retryer) aws_sdkv2.Retryer {
+	if p.MaxRetries > 0 {
+		r = retry_sdkv2.AddWithMaxAttempts(r, p.MaxRetries)
+	}
+
+	if p.hasBackoff() {
+		v2, ok := r.(aws_sdkv2.RetryerV2)
+		if !ok {
+			// AddWithMaxAttempts wraps any Retryer as a RetryerV2.
+			v2 = retry_sdkv2.AddWithMaxAttempts(r, r.MaxAttempts()).(aws_sdkv2.RetryerV2)
+		}
+
+		r = &withBackoffDelayer{
+			RetryerV2: v2,
+			backoff:   newExponentialJitterBackoff(p.minBackoff(), p.maxBackoff()),
+		}
+	}
+
+	if len(p.RetryableErrorCodes) > 0 {
+		r = retry_sdkv2.AddWithErrorCodes(r, p.RetryableErrorCodes...)
+	}
+
+	return r
+}
+
+// session returns a copy of the specified AWS SDK for Go v1 session with the retry policy applied.
+func (p *RetryPolicy) session(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	maxRetries := aws_sdkv1.IntValue(sess.Config.MaxRetries)
+	if p.MaxRetries > 0 {
+		maxRetries = p.MaxRetries
+	}
+
+	r := client_sdkv1.DefaultRetryer{
+		NumMaxRetries: maxRetries,
+	}
+	if p.hasBackoff() {
+		r.MinRetryDelay = p.minBackoff()
+		r.MinThrottleDelay = p.minBackoff()
+		r.MaxRetryDelay = p.maxBackoff()
+		r.MaxThrottleDelay = p.maxBackoff()
+	}
+
+	var retryer request_sdkv1.Retryer = r
+	if len(p.RetryableErrorCodes) > 0 {
+		codes := make(map[string]struct{}, len(p.RetryableErrorCodes))
+		for _, v := range p.RetryableErrorCodes {
+			codes[v] = struct{}{}
+		}
+
+		retryer = &withRetryableErrorCodesV1{
+			DefaultRetryer: r,
+			codes:          codes,
+		}
+	}
+
+	return sess.Copy(&aws_sdkv1.Config{
+		MaxRetries: aws_sdkv1.Int(maxRetries),
+		Retryer:    retryer,
+	})
+}
+
+type withBackoffDelayer struct {
+	aws_sdkv2.RetryerV2
+	backoff retry_sdkv2.BackoffDelayer
+}
+
+func (r *withBackoffDelayer) RetryDelay(attempt int, err error) (time.Duration, error) {
+	return r.backoff.BackoffDelay(attempt, err)
+}
+
+// exponentialJitterBackoff returns delays that double with each attempt, starting at minBackoff and capped at maxBackoff.
+// Each delay is randomized to between half and all of the computed value.
+type exponentialJitterBackoff struct {
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newExponentialJitterBackoff(minBackoff, maxBackoff time.Duration) *exponentialJitterBackoff {
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	return &exponentialJitterBackoff{
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+	}
+}
+
+func (b *exponentialJitterBackoff) BackoffDelay(attempt int, _ error) (time.Duration, error) {
+	delay := b.maxBackoff
+
+	if attempt < 1 {
+		attempt = 1
+	}
+	if shift := attempt - 1; shift < 32 {
+		if v := b.minBackoff << shift; v > 0 && v < b.maxBackoff {
+			delay = v
+		}
+	}
+
+	half := delay / 2
+
+	return half + time.Duration(rand.Int63n(int64(half)+1)), nil //nolint:gosec // Non-cryptographic jitter.
+}
+
+type withRetryableErrorCodesV1 struct {
+	client_sdkv1.DefaultRetryer
+	codes map[string]struct{}
+}
+
+func (r *withRetryableErrorCodesV1) ShouldRetry(req *request_sdkv1.Request) bool {
+	if err, ok := req.Error.(awserr.Error); ok {
+		if _, ok := r.codes[err.Code()]; ok {
+			return true
+		}
+	}
+
+	return r.DefaultRetryer.ShouldRetry(req)
+}
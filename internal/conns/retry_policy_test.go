@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+)
+
+func TestRetryPolicyRetryer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		policy            RetryPolicy
+		err               error
+		expectedAttempts  int
+		expectedRetryable bool
+	}{
+		{
+			name:              "empty policy",
+			err:               &smithy.GenericAPIError{Code: "ConcurrentModificationException"},
+			expectedAttempts:  retry.DefaultMaxAttempts,
+			expectedRetryable: false,
+		},
+		{
+			name: "max retries",
+			policy: RetryPolicy{
+				MaxRetries: 50,
+			},
+			err:               &smithy.GenericAPIError{Code: "ConcurrentModificationException"},
+			expectedAttempts:  50,
+			expectedRetryable: false,
+		},
+		{
+			name: "retryable error codes",
+			policy: RetryPolicy{
+				RetryableErrorCodes: []string{"ConcurrentModificationException"},
+			},
+			err:               &smithy.GenericAPIError{Code: "ConcurrentModificationException"},
+			expectedAttempts:  retry.DefaultMaxAttempts,
+			expectedRetryable: true,
+		},
+		{
+			name: "retryable error codes other error",
+			policy: RetryPolicy{
+				RetryableErrorCodes: []string{"ConcurrentModificationException"},
+			},
+			err:               errors.New("other"),
+			expectedAttempts:  retry.DefaultMaxAttempts,
+			expectedRetryable: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testCase.policy.retryer(retry.NewStandard())
+
+			if _, ok := r.(aws.RetryerV2); !ok {
+				t.Fatalf("retryer %T does not implement aws.RetryerV2", r)
+			}
+			if got, want := r.MaxAttempts(), testCase.expectedAttempts; got != want {
+				t.Errorf("MaxAttempts() = %d, want %d", got, want)
+			}
+			if got, want := r.IsErrorRetryable(testCase.err), testCase.expectedRetryable; got != want {
+				t.Errorf("IsErrorRetryable(%q) = %v, want %v", testCase.err, got, want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyRetryer_backoff(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{
+		MinBackoff: 2 * time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+	r := policy.retryer(retry.NewStandard())
+
+	testCases := []struct {
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{attempt: 1, min: 1 * time.Second, max: 2 * time.Second},
+		{attempt: 2, min: 2 * time.Second, max: 4 * time.Second},
+		{attempt: 3, min: 4 * time.Second, max: 8 * time.Second},
+		{attempt: 4, min: 5 * time.Second, max: 10 * time.Second},
+		{attempt: 100, min: 5 * time.Second, max: 10 * time.Second},
+	}
+
+	for _, testCase := range testCases {
+		for i := 0; i < 10; i++ {
+			got, err := r.RetryDelay(testCase.attempt, nil)
+			if err != nil {
+				t.Fatalf("RetryDelay(%d): %s", testCase.attempt, err)
+			}
+			if got < testCase.min || got > testCase.max {
+				t.Errorf("RetryDelay(%d) = %s, want between %s and %s", testCase.attempt, got, testCase.min, testCase.max)
+			}
+		}
+	}
+}
+
+func TestRetryPolicyAWSConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := &aws.Config{
+		Retryer: func() aws.Retryer {
+			return retry.AddWithMaxAttempts(retry.NewStandard(), 25)
+		},
+	}
+	policy := RetryPolicy{
+		MaxRetries: 5,
+	}
+
+	got := policy.awsConfig(cfg)
+
+	if got, want := got.Retryer().MaxAttempts(), 5; got != want {
+		t.Errorf("policy MaxAttempts() = %d, want %d", got, want)
+	}
+	if got, want := cfg.Retryer().MaxAttempts(), 25; got != want {
+		t.Errorf("original MaxAttempts() = %d, want %d", got, want)
+	}
+}
+
+func TestRetryPolicySession(t *testing.T) {
+	t.Parallel()
+
+	sess, err := session_sdkv1.NewSession(aws_sdkv1.NewConfig().WithMaxRetries(25).WithRegion("us-west-2")) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := RetryPolicy{
+		MaxRetries:          5,
+		RetryableErrorCodes: []string{"ConcurrentModificationException"},
+	}
+
+	got := policy.session(sess)
+
+	if got, want := aws_sdkv1.IntValue(got.Config.MaxRetries), 5; got != want {
+		t.Errorf("policy MaxRetries = %d, want %d", got, want)
+	}
+	if got, want := aws_sdkv1.IntValue(sess.Config.MaxRetries), 25; got != want {
+		t.Errorf("original MaxRetries = %d, want %d", got, want)
+	}
+
+	retryer, ok := got.Config.Retryer.(request_sdkv1.Retryer)
+	if !ok {
+		t.Fatalf("Retryer %T does not implement request.Retryer", got.Config.Retryer)
+	}
+
+	req := &request_sdkv1.Request{
+		Error: awserr.New("ConcurrentModificationException", "", nil),
+	}
+	if !retryer.ShouldRetry(req) {
+		t.Errorf("ShouldRetry(%q) = false, want true", req.Error)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	organizations_sdkv1 "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// tagPolicyConfig returns the tag policy to enforce, including any rules from the account's effective AWS Organizations tag policy.
+func tagPolicyConfig(ctx context.Context, client *AWSClient, policyConfig *tftags.PolicyConfig) (*tftags.PolicyConfig, error) {
+	if policyConfig == nil || !policyConfig.OrganizationsPolicy {
+		return policyConfig, nil
+	}
+
+	tflog.Debug(ctx, "Retrieving effective AWS Organizations tag policy")
+	input := &organizations_sdkv1.DescribeEffectivePolicyInput{
+		PolicyType: aws_sdkv1.String(organizations_sdkv1.EffectivePolicyTypeTagPolicy),
+	}
+
+	output, err := client.OrganizationsConn(ctx).DescribeEffectivePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, organizations_sdkv1.ErrCodeAWSOrganizationsNotInUseException, organizations_sdkv1.ErrCodeEffectivePolicyNotFoundException) {
+		tflog.Debug(ctx, "No effective AWS Organizations tag policy")
+		return policyConfig, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading effective AWS Organizations tag policy: %w", err)
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return policyConfig, nil
+	}
+
+	rules, err := tftags.OrganizationsPolicyRules(aws_sdkv1.StringValue(output.EffectivePolicy.PolicyContent))
+	if err != nil {
+		return nil, err
+	}
+
+	return policyConfig.WithRules(rules), nil
+}
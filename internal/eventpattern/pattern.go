@@ -0,0 +1,631 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Pattern is a parsed EventBridge event pattern.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html.
+type Pattern struct {
+	root *eventPatternNode
+}
+
+// eventPatternNode matches an event, or an object nested in an event.
+// All fields must match, and if present, at least one of the "$or" alternatives must match.
+type eventPatternNode struct {
+	fields       map[string]*eventPatternField
+	alternatives []*eventPatternNode
+}
+
+// eventPatternField matches the values of a field, either with a nested pattern or with a list of matchers.
+type eventPatternField struct {
+	nested   *eventPatternNode
+	matchers []eventValueMatcher
+}
+
+// eventValueMatcher reports whether the leaf values of a field match.
+// Arrays in the event are flattened, so a field can have any number of values.
+type eventValueMatcher func(values []interface{}) bool
+
+// Parse parses and validates an event pattern.
+func Parse(pattern string) (*Pattern, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(pattern), &v); err != nil {
+		return nil, fmt.Errorf("parsing event pattern: %w", err)
+	}
+
+	tfMap, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("event pattern must be a JSON object")
+	}
+
+	root, err := parseEventPatternNode(tfMap, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pattern{root: root}, nil
+}
+
+// MatchEvent reports whether the specified JSON event matches the pattern.
+func (p *Pattern) MatchEvent(event string) (bool, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(event), &v); err != nil {
+		return false, fmt.Errorf("parsing event: %w", err)
+	}
+
+	tfMap, ok := v.(map[string]interface{})
+	if !ok {
+		return false, errors.New("event must be a JSON object")
+	}
+
+	return p.root.match([]map[string]interface{}{tfMap}), nil
+}
+
+func (n *eventPatternNode) match(objects []map[string]interface{}) bool {
+	for key, field := range n.fields {
+		var values []interface{}
+		for _, object := range objects {
+			if v, ok := object[key]; ok {
+				values = appendEventValues(values, v)
+			}
+		}
+
+		if !field.match(values) {
+			return false
+		}
+	}
+
+	if len(n.alternatives) == 0 {
+		return true
+	}
+
+	for _, alternative := range n.alternatives {
+		if alternative.match(objects) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *eventPatternField) match(values []interface{}) bool {
+	if f.nested != nil {
+		var objects []map[string]interface{}
+		for _, v := range values {
+			if v, ok := v.(map[string]interface{}); ok {
+				objects = append(objects, v)
+			}
+		}
+
+		return f.nested.match(objects)
+	}
+
+	var leaves []interface{}
+	for _, v := range values {
+		if _, ok := v.(map[string]interface{}); !ok {
+			leaves = append(leaves, v)
+		}
+	}
+
+	for _, matcher := range f.matchers {
+		if matcher(leaves) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendEventValues appends an event field's value, flattening arrays.
+func appendEventValues(values []interface{}, v interface{}) []interface{} {
+	if v, ok := v.([]interface{}); ok {
+		for _, v := range v {
+			values = appendEventValues(values, v)
+		}
+
+		return values
+	}
+
+	return append(values, v)
+}
+
+func parseEventPatternNode(tfMap map[string]interface{}, path string) (*eventPatternNode, error) {
+	node := &eventPatternNode{
+		fields: make(map[string]*eventPatternField),
+	}
+
+	keys := tfmaps.Keys(tfMap)
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		v := tfMap[key]
+		fieldPath := eventPatternPath(path, key)
+
+		if key == "$or" {
+			alternatives, ok := v.([]interface{})
+			if !ok || len(alternatives) < 2 {
+				return nil, fmt.Errorf("%s: must be an array of at least 2 patterns", fieldPath)
+			}
+
+			for i, v := range alternatives {
+				tfMap, ok := v.(map[string]interface{})
+				if !ok || len(tfMap) == 0 {
+					return nil, fmt.Errorf("%s[%d]: must be a non-empty JSON object", fieldPath, i)
+				}
+
+				alternative, err := parseEventPatternNode(tfMap, path)
+				if err != nil {
+					return nil, err
+				}
+
+				node.alternatives = append(node.alternatives, alternative)
+			}
+
+			continue
+		}
+
+		field := &eventPatternField{}
+
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: must not be empty", fieldPath)
+			}
+
+			nested, err := parseEventPatternNode(v, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+
+			field.nested = nested
+		case []interface{}:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: must not be empty", fieldPath)
+			}
+
+			for i, v := range v {
+				matcher, err := parseEventValueMatcher(v)
+				if err != nil {
+					return nil, fmt.Errorf("%s[%d]: %w", fieldPath, i, err)
+				}
+
+				field.matchers = append(field.matchers, matcher)
+			}
+		default:
+			return nil, fmt.Errorf("%s: must be a JSON object or array", fieldPath)
+		}
+
+		node.fields[key] = field
+	}
+
+	return node, nil
+}
+
+func eventPatternPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// parseEventValueMatcher parses an element of a pattern array: either a literal value or a content filter.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-pattern-operators.html.
+func parseEventValueMatcher(v interface{}) (eventValueMatcher, error) {
+	filter, ok := v.(map[string]interface{})
+	if !ok {
+		return anyEventValue(func(value interface{}) bool {
+			return eventValuesEqual(v, value)
+		}), nil
+	}
+
+	if len(filter) != 1 {
+		return nil, errors.New("content filter must have exactly one key")
+	}
+
+	for operator, operand := range filter {
+		switch operator {
+		case "anything-but":
+			return parseAnythingButMatcher(operand)
+		case "cidr":
+			s, ok := operand.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a string", operator)
+			}
+
+			prefix, err := netip.ParsePrefix(s)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", operator, err)
+			}
+
+			return anyEventValue(func(value interface{}) bool {
+				s, ok := value.(string)
+				if !ok {
+					return false
+				}
+
+				addr, err := netip.ParseAddr(s)
+
+				return err == nil && prefix.Contains(addr)
+			}), nil
+		case "equals-ignore-case":
+			s, ok := operand.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a string", operator)
+			}
+
+			return anyEventValue(stringEventValue(func(value string) bool {
+				return strings.EqualFold(value, s)
+			})), nil
+		case "exists":
+			exists, ok := operand.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a boolean", operator)
+			}
+
+			return func(values []interface{}) bool {
+				return (len(values) > 0) == exists
+			}, nil
+		case "numeric":
+			f, err := parseNumericMatcher(operand)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", operator, err)
+			}
+
+			return anyEventValue(func(value interface{}) bool {
+				v, ok := value.(float64)
+
+				return ok && f(v)
+			}), nil
+		case "prefix", "suffix":
+			f, err := parseAffixMatcher(operator, operand)
+			if err != nil {
+				return nil, err
+			}
+
+			return anyEventValue(stringEventValue(f)), nil
+		case "wildcard":
+			s, ok := operand.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a string", operator)
+			}
+
+			if err := validateEventPatternWildcard(s); err != nil {
+				return nil, fmt.Errorf("%s: %w", operator, err)
+			}
+
+			return anyEventValue(stringEventValue(func(value string) bool {
+				return eventPatternWildcardMatch(s, value)
+			})), nil
+		default:
+			return nil, fmt.Errorf("unsupported content filter (%s)", operator)
+		}
+	}
+
+	return nil, nil // Unreachable.
+}
+
+// parseAnythingButMatcher parses the operand of an "anything-but" filter, which matches any value other than those specified.
+// A missing field does not match.
+func parseAnythingButMatcher(operand interface{}) (eventValueMatcher, error) {
+	const operator = "anything-but"
+	var excluded func(interface{}) bool
+
+	switch operand := operand.(type) {
+	case string, float64:
+		excluded = func(value interface{}) bool {
+			return eventValuesEqual(operand, value)
+		}
+	case []interface{}:
+		if len(operand) == 0 {
+			return nil, fmt.Errorf("%s: must not be empty", operator)
+		}
+
+		for _, v := range operand {
+			switch v.(type) {
+			case string, float64:
+			default:
+				return nil, fmt.Errorf("%s: array elements must be strings or numbers", operator)
+			}
+		}
+
+		excluded = func(value interface{}) bool {
+			for _, v := range operand {
+				if eventValuesEqual(v, value) {
+					return true
+				}
+			}
+
+			return false
+		}
+	case map[string]interface{}:
+		if len(operand) != 1 {
+			return nil, fmt.Errorf("%s: content filter must have exactly one key", operator)
+		}
+
+		for k, v := range operand {
+			var f func(string) bool
+
+			switch k {
+			case "equals-ignore-case":
+				ss, err := eventPatternStrings(v)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s: %w", operator, k, err)
+				}
+
+				f = func(value string) bool {
+					for _, s := range ss {
+						if strings.EqualFold(value, s) {
+							return true
+						}
+					}
+
+					return false
+				}
+			case "wildcard":
+				ss, err := eventPatternStrings(v)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s: %w", operator, k, err)
+				}
+
+				for _, s := range ss {
+					if err := validateEventPatternWildcard(s); err != nil {
+						return nil, fmt.Errorf("%s: %s: %w", operator, k, err)
+					}
+				}
+
+				f = func(value string) bool {
+					for _, s := range ss {
+						if eventPatternWildcardMatch(s, value) {
+							return true
+						}
+					}
+
+					return false
+				}
+			case "prefix", "suffix":
+				var err error
+				f, err = parseAffixMatcher(k, v)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", operator, err)
+				}
+			default:
+				return nil, fmt.Errorf("%s: unsupported content filter (%s)", operator, k)
+			}
+
+			excluded = stringEventValue(f)
+		}
+	default:
+		return nil, fmt.Errorf("%s: must be a string, number, array or JSON object", operator)
+	}
+
+	return anyEventValue(func(value interface{}) bool {
+		return !excluded(value)
+	}), nil
+}
+
+// parseAffixMatcher parses the operand of a "prefix" or "suffix" filter, which is either a string or {"equals-ignore-case": string}.
+func parseAffixMatcher(operator string, operand interface{}) (func(string) bool, error) {
+	ignoreCase := false
+
+	if v, ok := operand.(map[string]interface{}); ok {
+		s, ok := v["equals-ignore-case"]
+		if len(v) != 1 || !ok {
+			return nil, fmt.Errorf("%s: only equals-ignore-case is supported", operator)
+		}
+
+		operand, ignoreCase = s, true
+	}
+
+	s, ok := operand.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a string", operator)
+	}
+
+	has := strings.HasPrefix
+	if operator == "suffix" {
+		has = strings.HasSuffix
+	}
+
+	if ignoreCase {
+		s = strings.ToLower(s)
+	}
+
+	return func(value string) bool {
+		if ignoreCase {
+			value = strings.ToLower(value)
+		}
+
+		return has(value, s)
+	}, nil
+}
+
+// parseNumericMatcher parses the operand of a "numeric" filter, such as [">", 0, "<=", 5].
+func parseNumericMatcher(operand interface{}) (func(float64) bool, error) {
+	tfList, ok := operand.([]interface{})
+	if !ok || len(tfList) == 0 || len(tfList)%2 != 0 || len(tfList) > 4 {
+		return nil, errors.New("must be an array of 1 or 2 operator and number pairs")
+	}
+
+	var fs []func(float64) bool
+	for i := 0; i < len(tfList); i += 2 {
+		operator, ok := tfList[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("operator (%v) must be a string", tfList[i])
+		}
+
+		n, ok := tfList[i+1].(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s: operand (%v) must be a number", operator, tfList[i+1])
+		}
+
+		var f func(float64) bool
+		switch operator {
+		case "=":
+			f = func(v float64) bool { return v == n }
+		case "<":
+			f = func(v float64) bool { return v < n }
+		case "<=":
+			f = func(v float64) bool { return v <= n }
+		case ">":
+			f = func(v float64) bool { return v > n }
+		case ">=":
+			f = func(v float64) bool { return v >= n }
+		default:
+			return nil, fmt.Errorf("unsupported operator (%s)", operator)
+		}
+
+		fs = append(fs, f)
+	}
+
+	return func(v float64) bool {
+		for _, f := range fs {
+			if !f(v) {
+				return false
+			}
+		}
+
+		return true
+	}, nil
+}
+
+func anyEventValue(f func(interface{}) bool) eventValueMatcher {
+	return func(values []interface{}) bool {
+		for _, v := range values {
+			if f(v) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func stringEventValue(f func(string) bool) func(interface{}) bool {
+	return func(value interface{}) bool {
+		s, ok := value.(string)
+
+		return ok && f(s)
+	}
+}
+
+// eventValuesEqual reports whether a literal pattern value equals an event value.
+// Values of different JSON types are never equal.
+func eventValuesEqual(v1, v2 interface{}) bool {
+	switch v1 := v1.(type) {
+	case nil:
+		return v2 == nil
+	case bool:
+		v2, ok := v2.(bool)
+		return ok && v1 == v2
+	case float64:
+		v2, ok := v2.(float64)
+		return ok && v1 == v2
+	case string:
+		v2, ok := v2.(string)
+		return ok && v1 == v2
+	}
+
+	return false
+}
+
+func eventPatternStrings(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, errors.New("must not be empty")
+		}
+
+		ss := make([]string, 0, len(v))
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, errors.New("array elements must be strings")
+			}
+
+			ss = append(ss, s)
+		}
+
+		return ss, nil
+	}
+
+	return nil, errors.New("must be a string or an array of strings")
+}
+
+func validateEventPatternWildcard(s string) error {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 == len(s) || (s[i+1] != '*' && s[i+1] != '\\') {
+				return fmt.Errorf("invalid escape sequence in (%s)", s)
+			}
+			i++
+		case '*':
+			if i+1 < len(s) && s[i+1] == '*' {
+				return fmt.Errorf("consecutive wildcard characters are not allowed in (%s)", s)
+			}
+		}
+	}
+
+	return nil
+}
+
+// eventPatternWildcardMatch reports whether value matches a wildcard pattern in which '*' matches any sequence of characters.
+// "\*" and "\\" match a literal '*' and '\'.
+func eventPatternWildcardMatch(pattern, value string) bool {
+	type token struct {
+		c    byte
+		star bool
+	}
+
+	var tokens []token
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			tokens = append(tokens, token{c: pattern[i]})
+		case c == '*':
+			tokens = append(tokens, token{star: true})
+		default:
+			tokens = append(tokens, token{c: c})
+		}
+	}
+
+	t, v := 0, 0
+	star, backtrack := -1, 0
+
+	for v < len(value) {
+		switch {
+		case t < len(tokens) && tokens[t].star:
+			star, backtrack = t, v
+			t++
+		case t < len(tokens) && tokens[t].c == value[v]:
+			t++
+			v++
+		case star != -1:
+			backtrack++
+			t, v = star+1, backtrack
+		default:
+			return false
+		}
+	}
+
+	for t < len(tokens) && tokens[t].star {
+		t++
+	}
+
+	return t == len(tokens)
+}
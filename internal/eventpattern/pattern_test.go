@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestPatternMatchEvent(t *testing.T) {
+	t.Parallel()
+
+	const event = `{
+  "id": "7bf73129-1428-4cd3-a780-95db273d1602",
+  "detail-type": "EC2 Instance State-change Notification",
+  "source": "aws.ec2",
+  "account": "123456789012",
+  "time": "2015-11-11T21:29:54Z",
+  "region": "us-east-1",
+  "resources": ["arn:aws:ec2:us-east-1:123456789012:instance/i-abcd1111"],
+  "detail": {
+    "instance-id": "i-abcd1111",
+    "state": "pending",
+    "c-count": 5,
+    "d-count": 4.5,
+    "source-ip": "10.0.0.255",
+    "is-spot": false,
+    "owner": null,
+    "tags": [{"key": "Env", "value": "Prod"}, {"key": "Team", "value": "Blue"}],
+    "ids": ["a", "b"],
+    "file": "dir/file.PNG"
+  }
+}`
+
+	testCases := map[string]struct {
+		pattern  string
+		expected bool
+	}{
+		"source": {
+			pattern:  `{"source": ["aws.ec2"]}`,
+			expected: true,
+		},
+		"source no match": {
+			pattern:  `{"source": ["aws.s3"]}`,
+			expected: false,
+		},
+		"multiple fields": {
+			pattern:  `{"source": ["aws.ec2"], "detail-type": ["EC2 Instance State-change Notification"], "detail": {"state": ["running", "pending"]}}`,
+			expected: true,
+		},
+		"multiple fields one no match": {
+			pattern:  `{"source": ["aws.ec2"], "detail": {"state": ["running"]}}`,
+			expected: false,
+		},
+		"missing field": {
+			pattern:  `{"detail": {"missing": ["x"]}}`,
+			expected: false,
+		},
+		"array event value": {
+			pattern:  `{"resources": ["arn:aws:ec2:us-east-1:123456789012:instance/i-abcd1111"], "detail": {"ids": ["b"]}}`,
+			expected: true,
+		},
+		"array of objects": {
+			pattern:  `{"detail": {"tags": {"key": ["Team"], "value": ["Prod"]}}}`,
+			expected: true,
+		},
+		"number": {
+			pattern:  `{"detail": {"c-count": [5.0]}}`,
+			expected: true,
+		},
+		"number and string": {
+			pattern:  `{"detail": {"c-count": ["5"]}}`,
+			expected: false,
+		},
+		"boolean": {
+			pattern:  `{"detail": {"is-spot": [false]}}`,
+			expected: true,
+		},
+		"null": {
+			pattern:  `{"detail": {"owner": [null]}}`,
+			expected: true,
+		},
+		"null missing field": {
+			pattern:  `{"detail": {"missing": [null]}}`,
+			expected: false,
+		},
+		"prefix": {
+			pattern:  `{"detail": {"instance-id": [{"prefix": "i-"}]}}`,
+			expected: true,
+		},
+		"prefix equals-ignore-case": {
+			pattern:  `{"detail": {"instance-id": [{"prefix": {"equals-ignore-case": "I-ABCD"}}]}}`,
+			expected: true,
+		},
+		"suffix": {
+			pattern:  `{"detail": {"file": [{"suffix": ".png"}]}}`,
+			expected: false,
+		},
+		"suffix equals-ignore-case": {
+			pattern:  `{"detail": {"file": [{"suffix": {"equals-ignore-case": ".png"}}]}}`,
+			expected: true,
+		},
+		"anything-but string": {
+			pattern:  `{"detail": {"state": [{"anything-but": "running"}]}}`,
+			expected: true,
+		},
+		"anything-but list": {
+			pattern:  `{"detail": {"state": [{"anything-but": ["running", "pending"]}]}}`,
+			expected: false,
+		},
+		"anything-but number": {
+			pattern:  `{"detail": {"c-count": [{"anything-but": 5}]}}`,
+			expected: false,
+		},
+		"anything-but prefix": {
+			pattern:  `{"detail": {"state": [{"anything-but": {"prefix": "pend"}}]}}`,
+			expected: false,
+		},
+		"anything-but suffix": {
+			pattern:  `{"detail": {"state": [{"anything-but": {"suffix": "ing"}}]}}`,
+			expected: false,
+		},
+		"anything-but equals-ignore-case": {
+			pattern:  `{"detail": {"state": [{"anything-but": {"equals-ignore-case": ["RUNNING", "STOPPED"]}}]}}`,
+			expected: true,
+		},
+		"anything-but wildcard": {
+			pattern:  `{"detail": {"file": [{"anything-but": {"wildcard": "*.PNG"}}]}}`,
+			expected: false,
+		},
+		"anything-but missing field": {
+			pattern:  `{"detail": {"missing": [{"anything-but": "x"}]}}`,
+			expected: false,
+		},
+		"numeric range": {
+			pattern:  `{"detail": {"c-count": [{"numeric": [">", 0, "<=", 5]}], "d-count": [{"numeric": ["<", 10]}]}}`,
+			expected: true,
+		},
+		"numeric equals": {
+			pattern:  `{"detail": {"d-count": [{"numeric": ["=", 4.5]}]}}`,
+			expected: true,
+		},
+		"numeric out of range": {
+			pattern:  `{"detail": {"c-count": [{"numeric": [">", 5]}]}}`,
+			expected: false,
+		},
+		"numeric string": {
+			pattern:  `{"detail": {"state": [{"numeric": [">", 0]}]}}`,
+			expected: false,
+		},
+		"exists": {
+			pattern:  `{"detail": {"state": [{"exists": true}], "missing": [{"exists": false}]}}`,
+			expected: true,
+		},
+		"exists no match": {
+			pattern:  `{"detail": {"missing": [{"exists": true}]}}`,
+			expected: false,
+		},
+		"exists not leaf": {
+			pattern:  `{"detail": [{"exists": true}]}`,
+			expected: false,
+		},
+		"exists nested missing": {
+			pattern:  `{"missing": {"field": [{"exists": false}]}}`,
+			expected: true,
+		},
+		"cidr": {
+			pattern:  `{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`,
+			expected: true,
+		},
+		"cidr no match": {
+			pattern:  `{"detail": {"source-ip": [{"cidr": "10.0.1.0/24"}]}}`,
+			expected: false,
+		},
+		"equals-ignore-case": {
+			pattern:  `{"detail-type": [{"equals-ignore-case": "ec2 instance state-change notification"}]}`,
+			expected: true,
+		},
+		"wildcard": {
+			pattern:  `{"resources": [{"wildcard": "arn:aws:ec2:*:123456789012:instance/*"}]}`,
+			expected: true,
+		},
+		"wildcard no match": {
+			pattern:  `{"detail": {"file": [{"wildcard": "dir/*.png"}]}}`,
+			expected: false,
+		},
+		"or": {
+			pattern:  `{"source": ["aws.ec2"], "$or": [{"detail": {"state": ["running"]}}, {"detail": {"c-count": [{"numeric": [">", 1]}]}}]}`,
+			expected: true,
+		},
+		"or no match": {
+			pattern:  `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["running"]}}]}`,
+			expected: false,
+		},
+		"nested or": {
+			pattern:  `{"detail": {"$or": [{"state": ["running"]}, {"instance-id": ["i-abcd1111"]}]}}`,
+			expected: true,
+		},
+		"multiple matchers": {
+			pattern:  `{"detail": {"state": ["running", {"prefix": "pen"}]}}`,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pattern, err := Parse(testCase.pattern)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := pattern.MatchEvent(event)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern string
+		wantErr string
+	}{
+		"invalid JSON": {
+			pattern: `{`,
+			wantErr: `parsing event pattern`,
+		},
+		"not an object": {
+			pattern: `["aws.ec2"]`,
+			wantErr: `event pattern must be a JSON object`,
+		},
+		"leaf value": {
+			pattern: `{"source": "aws.ec2"}`,
+			wantErr: `source: must be a JSON object or array`,
+		},
+		"empty array": {
+			pattern: `{"detail": {"state": []}}`,
+			wantErr: `detail.state: must not be empty`,
+		},
+		"unsupported filter": {
+			pattern: `{"source": [{"contains": "ec2"}]}`,
+			wantErr: `source\[0\]: unsupported content filter \(contains\)`,
+		},
+		"multiple filter keys": {
+			pattern: `{"source": [{"prefix": "aws.", "suffix": "ec2"}]}`,
+			wantErr: `source\[0\]: content filter must have exactly one key`,
+		},
+		"prefix number": {
+			pattern: `{"source": [{"prefix": 1}]}`,
+			wantErr: `source\[0\]: prefix: must be a string`,
+		},
+		"numeric operator": {
+			pattern: `{"detail": {"count": [{"numeric": ["!=", 1]}]}}`,
+			wantErr: `detail.count\[0\]: numeric: unsupported operator \(!=\)`,
+		},
+		"numeric odd": {
+			pattern: `{"detail": {"count": [{"numeric": [">", 1, "<"]}]}}`,
+			wantErr: `numeric: must be an array of 1 or 2 operator and number pairs`,
+		},
+		"numeric operand": {
+			pattern: `{"detail": {"count": [{"numeric": [">", "1"]}]}}`,
+			wantErr: `numeric: >: operand \(1\) must be a number`,
+		},
+		"exists": {
+			pattern: `{"detail": {"count": [{"exists": "true"}]}}`,
+			wantErr: `exists: must be a boolean`,
+		},
+		"cidr": {
+			pattern: `{"detail": {"ip": [{"cidr": "10.0.0.0"}]}}`,
+			wantErr: `detail.ip\[0\]: cidr: `,
+		},
+		"wildcard consecutive": {
+			pattern: `{"source": [{"wildcard": "aws.**"}]}`,
+			wantErr: `wildcard: consecutive wildcard characters are not allowed`,
+		},
+		"anything-but empty": {
+			pattern: `{"source": [{"anything-but": []}]}`,
+			wantErr: `anything-but: must not be empty`,
+		},
+		"anything-but filter": {
+			pattern: `{"source": [{"anything-but": {"numeric": [">", 1]}}]}`,
+			wantErr: `anything-but: unsupported content filter \(numeric\)`,
+		},
+		"or single": {
+			pattern: `{"$or": [{"source": ["aws.ec2"]}]}`,
+			wantErr: `\$or: must be an array of at least 2 patterns`,
+		},
+		"or element": {
+			pattern: `{"detail": {"$or": [{"state": ["running"]}, "x"]}}`,
+			wantErr: `detail.\$or\[1\]: must be a non-empty JSON object`,
+		},
+		"or nested": {
+			pattern: `{"detail": {"$or": [{"state": ["running"]}, {"count": 1}]}}`,
+			wantErr: `detail.count: must be a JSON object or array`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testCase.pattern)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !regexache.MustCompile(testCase.wantErr).MatchString(err.Error()) {
+				t.Errorf("got error %q, expected match for %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestEventPatternWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{"*", "", true},
+		{"*", "abc", true},
+		{"a*c", "abbbc", true},
+		{"a*c", "abcd", false},
+		{"*.png", "a.png.png", true},
+		{"a*b*c", "aXbYc", true},
+		{"a*b*c", "aXcYb", false},
+		{`a\*c`, "a*c", true},
+		{`a\*c`, "abc", false},
+		{`a\\*`, `a\bc`, true},
+		{"abc", "ABC", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := eventPatternWildcardMatch(testCase.pattern, testCase.value); got != testCase.expected {
+			t.Errorf("eventPatternWildcardMatch(%q, %q) = %t, expected %t", testCase.pattern, testCase.value, got, testCase.expected)
+		}
+	}
+}
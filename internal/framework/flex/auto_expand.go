@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -26,7 +27,9 @@ import (
 // target data type) are copied.
 func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
-	expander := &autoExpander{}
+	expander := &autoExpander{
+		autoFlexOptions: newAutoFlexOptions(autoFlexTagNoExpand),
+	}
 
 	for _, optFn := range optFns {
 		optFn(expander)
@@ -41,7 +44,37 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	return diags
 }
 
-type autoExpander struct{}
+type autoExpander struct {
+	autoFlexOptions
+	unionMembers map[reflect.Type][]reflect.Type // Smithy union member types, keyed by union (interface) type.
+}
+
+func (expander autoExpander) getOptions() autoFlexOptions {
+	return expander.autoFlexOptions
+}
+
+// WithUnionMembers is an Expand option that registers the member types of the Smithy union type T.
+// A Plugin Framework nested object is expanded to a union value by mapping the object's single non-null field
+// to the member type named after the field, e.g. field `Static` maps to member type `PolicyDefinitionMemberStatic`.
+//
+//	flex.Expand(ctx, data, &input, flex.WithUnionMembers[awstypes.PolicyDefinition](&awstypes.PolicyDefinitionMemberStatic{}, &awstypes.PolicyDefinitionMemberTemplateLinked{}))
+func WithUnionMembers[T any](members ...T) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		expander, ok := flexer.(*autoExpander)
+		if !ok {
+			return
+		}
+
+		if expander.unionMembers == nil {
+			expander.unionMembers = make(map[reflect.Type][]reflect.Type)
+		}
+
+		tUnion := reflect.TypeOf((*T)(nil)).Elem()
+		for _, v := range members {
+			expander.unionMembers[tUnion] = append(expander.unionMembers[tUnion], reflect.TypeOf(v))
+		}
+	}
+}
 
 // convert converts a single Plugin Framework value to its AWS API equivalent.
 func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.Value) diag.Diagnostics {
@@ -262,6 +295,23 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 			return diags
 		}
 
+	case reflect.Interface:
+		//
+		// fwtypes.SmithyJSON -> Smithy document.
+		//
+		if vFrom, ok := vFrom.(fwtypes.SmithyDocumentValue); ok {
+			v, d := vFrom.ToSmithyDocument(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if v := reflect.ValueOf(v); v.IsValid() && v.Type().AssignableTo(tTo) {
+				vTo.Set(v)
+				return diags
+			}
+		}
+
 	case reflect.Ptr:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.String:
@@ -327,6 +377,15 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object -> Smithy union.
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -625,17 +684,17 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 
 		case reflect.Interface:
 			//
-			// types.List(OfObject) -> []interface.
+			// types.List(OfObject) -> []Smithy union.
 			//
-			// Smithy union type handling not yet implemented. Silently skip.
+			diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, tTo, vTo)...)
 			return diags
 		}
 
 	case reflect.Interface:
 		//
-		// types.List(OfObject) -> interface.
+		// types.List(OfObject) -> Smithy union.
 		//
-		// Smithy union type handling not yet implemented. Silently skip.
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, vTo)...)
 		return diags
 	}
 
@@ -707,6 +766,166 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API Smithy union value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := reflect.ValueOf(from)
+	if f.IsNil() {
+		return diags
+	}
+
+	member, d := expander.unionMember(ctx, f.Elem(), vTo.Type())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if member.IsValid() {
+		vTo.Set(member)
+	}
+
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []Smithy union value.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tSlice reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create a new target slice and expand each element.
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, 0, n)
+	for i := 0; i < n; i++ {
+		member, d := expander.unionMember(ctx, f.Index(i).Elem(), tSlice.Elem())
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if member.IsValid() {
+			t = reflect.Append(t, member)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// unionMember returns the AWS API Smithy union member value corresponding to the single non-null field of the specified Plugin Framework struct value.
+// An invalid value is returned if no member field is set.
+func (expander autoExpander) unionMember(ctx context.Context, valFrom reflect.Value, tUnion reflect.Type) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	members := expander.unionMembers[tUnion]
+	if len(members) == 0 {
+		tflog.Info(ctx, "AutoFlex Expand; no Smithy union members registered", map[string]interface{}{
+			"to": tUnion.String(),
+		})
+
+		return reflect.Value{}, diags
+	}
+
+	var (
+		fieldNames []string
+		member     reflect.Value
+	)
+	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		v, ok := valFrom.Field(i).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		// An empty collection of nested objects is not set.
+		if v, ok := v.(fwtypes.NestedObjectCollectionValue); ok {
+			from, d := v.ToObjectSlice(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return reflect.Value{}, diags
+			}
+
+			if reflect.ValueOf(from).Len() == 0 {
+				continue
+			}
+		}
+
+		fieldNames = append(fieldNames, field.Name)
+		if len(fieldNames) > 1 {
+			continue
+		}
+
+		tMember := findUnionMember(field.Name, tUnion, members)
+		if tMember == nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("no Smithy union (%s) member for field (%s)", tUnion, field.Name))
+			return reflect.Value{}, diags
+		}
+
+		// Create a new union member and expand into its value.
+		if tMember.Kind() == reflect.Ptr {
+			member = reflect.New(tMember.Elem())
+			diags.Append(expander.convert(ctx, valFrom.Field(i), member.Elem().FieldByName("Value"))...)
+		} else {
+			member = reflect.New(tMember).Elem()
+			diags.Append(expander.convert(ctx, valFrom.Field(i), member.FieldByName("Value"))...)
+		}
+		if diags.HasError() {
+			return reflect.Value{}, diags
+		}
+	}
+
+	if len(fieldNames) > 1 {
+		diags.AddError("AutoFlEx", fmt.Sprintf("more than one Smithy union (%s) member set: %s", tUnion, strings.Join(fieldNames, ", ")))
+		return reflect.Value{}, diags
+	}
+
+	return member, diags
+}
+
+// findUnionMember returns the Smithy union member type corresponding to the specified field name.
+// Member types are named <union>Member<field>, e.g. PolicyDefinitionMemberStatic.
+func findUnionMember(fieldName string, tUnion reflect.Type, members []reflect.Type) reflect.Type {
+	for _, tMember := range members {
+		tStruct := tMember
+		if tStruct.Kind() == reflect.Ptr {
+			tStruct = tStruct.Elem()
+		}
+
+		if tStruct.Kind() != reflect.Struct {
+			continue
+		}
+
+		if _, ok := tStruct.FieldByName("Value"); !ok {
+			continue
+		}
+
+		if memberName, ok := strings.CutPrefix(tStruct.Name(), tUnion.Name()+"Member"); ok && strings.EqualFold(memberName, fieldName) {
+			return tMember
+		}
+	}
+
+	return nil
+}
+
 // nestedKeyObjectToMap copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API map[string]struct value.
 func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -5,6 +5,7 @@ package flex
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -797,8 +799,223 @@ func TestExpandComplexNestedBlockWithStringEnum(t *testing.T) {
 	runAutoExpandTestCases(ctx, t, testCases)
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	unionMembers := WithUnionMembers[TestFlexUnionAWS](&TestFlexUnionAWSMemberAttr1{}, &TestFlexUnionAWSMemberNested{})
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "primitive member",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				Attr1:  types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberAttr1{Value: "a"}},
+		},
+		{
+			TestName: "nested object member",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				Attr1:  types.StringNull(),
+				Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberNested{Value: TestFlexAWS01{Field1: "b"}}},
+		},
+		{
+			TestName: "empty nested object member",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				Attr1:  types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexTF01{}),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberAttr1{Value: "a"}},
+		},
+		{
+			TestName: "more than one member",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				Attr1:  types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+			})},
+			Target:  &TestFlexUnionAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "no members set",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				Attr1:  types.StringNull(),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName:   "null union",
+			Options:    []AutoFlexOptionsFunc{unionMembers},
+			Source:     &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName: "no registered members",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				Attr1:  types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName: "slice of unions",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{
+				{
+					Attr1:  types.StringValue("a"),
+					Nested: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				},
+				{
+					Attr1:  types.StringNull(),
+					Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+				},
+			})},
+			Target: &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: []TestFlexUnionAWS{
+				&TestFlexUnionAWSMemberAttr1{Value: "a"},
+				&TestFlexUnionAWSMemberNested{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "JSON object",
+			Source: &TestFlexSmithyDocumentTF01{
+				Field1: fwtypes.SmithyJSONValue(`{"field1": "a", "field2": [1, 2]}`, newTestFlexSmithyDocument),
+			},
+			Target: &TestFlexSmithyDocumentAWS01{},
+			WantTarget: &TestFlexSmithyDocumentAWS01{
+				Field1: &TestFlexSmithyDocumentValue{Value: map[string]any{"field1": "a", "field2": []any{float64(1), float64(2)}}},
+			},
+		},
+		{
+			TestName: "JSON array",
+			Source: &TestFlexSmithyDocumentTF01{
+				Field1: fwtypes.SmithyJSONValue(`["a", "b"]`, newTestFlexSmithyDocument),
+			},
+			Target:     &TestFlexSmithyDocumentAWS01{},
+			WantTarget: &TestFlexSmithyDocumentAWS01{Field1: &TestFlexSmithyDocumentValue{Value: []any{"a", "b"}}},
+		},
+		{
+			TestName:   "null",
+			Source:     &TestFlexSmithyDocumentTF01{Field1: fwtypes.SmithyJSONNull(newTestFlexSmithyDocument)},
+			Target:     &TestFlexSmithyDocumentAWS01{},
+			WantTarget: &TestFlexSmithyDocumentAWS01{},
+		},
+		{
+			TestName: "invalid JSON",
+			Source: &TestFlexSmithyDocumentTF01{
+				Field1: fwtypes.SmithyJSONValue(`{"field1": `, newTestFlexSmithyDocument),
+			},
+			Target:  &TestFlexSmithyDocumentAWS01{},
+			WantErr: true,
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testTime := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	dateConverter := WithConverter(func(ctx context.Context, from types.String) (time.Time, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		if from.IsNull() {
+			return time.Time{}, diags
+		}
+
+		t, err := time.Parse(time.DateOnly, from.ValueString())
+		if err != nil {
+			diags.AddError("parsing date", err.Error())
+		}
+
+		return t, diags
+	})
+	enumConverter := WithConverter(func(ctx context.Context, from fwtypes.StringEnum[TestEnum]) (int32, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		return int32(slices.Index(TestEnum("").Values(), from.ValueEnum())), diags
+	})
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "autoflex struct tags",
+			Source: &TestFlexTagsTF01{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+				Field3: types.StringValue("c"),
+				Field4: types.StringValue("d"),
+			},
+			Target:     &TestFlexTagsAWS01{},
+			WantTarget: &TestFlexTagsAWS01{Field3: "c", Other1: "a"},
+		},
+		{
+			TestName:   "field name mapping",
+			Options:    []AutoFlexOptionsFunc{WithFieldNameMapping("Field1", "Other1")},
+			Source:     &TestFlexTF01{Field1: types.StringValue("a")},
+			Target:     &TestFlexOtherAWS01{},
+			WantTarget: &TestFlexOtherAWS01{Other1: "a"},
+		},
+		{
+			TestName:   "ignored field names",
+			Options:    []AutoFlexOptionsFunc{WithIgnoredFieldNames("Field1")},
+			Source:     &TestFlexTF01{Field1: types.StringValue("a")},
+			Target:     &TestFlexAWS01{},
+			WantTarget: &TestFlexAWS01{},
+		},
+		{
+			TestName: "converters",
+			Options:  []AutoFlexOptionsFunc{dateConverter, enumConverter},
+			Source: &TestFlexConvTF01{
+				Field1: types.StringValue("2024-03-01"),
+				Field2: fwtypes.StringEnumValue(TestEnumList),
+			},
+			Target:     &TestFlexConvAWS01{},
+			WantTarget: &TestFlexConvAWS01{Field1: testTime, Field2: 1},
+		},
+		{
+			TestName: "converter error",
+			Options:  []AutoFlexOptionsFunc{dateConverter},
+			Source: &TestFlexConvTF01{
+				Field1: types.StringValue("01/03/2024"),
+				Field2: fwtypes.StringEnumNull[TestEnum](),
+			},
+			Target:  &TestFlexConvAWS01{},
+			WantErr: true,
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Context    context.Context //nolint:containedctx // testing context use
+	Options    []AutoFlexOptionsFunc
 	TestName   string
 	Source     any
 	Target     any
@@ -821,7 +1038,7 @@ func runAutoExpandTestCases(ctx context.Context, t *testing.T, testCases autoFle
 				testCtx = testCase.Context
 			}
 
-			err := Expand(testCtx, testCase.Source, testCase.Target)
+			err := Expand(testCtx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
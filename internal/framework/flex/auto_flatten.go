@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	smithydocument "github.com/aws/smithy-go/document"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -29,7 +30,9 @@ import (
 // suitable target data type) are copied.
 func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
-	flattener := &autoFlattener{}
+	flattener := &autoFlattener{
+		autoFlexOptions: newAutoFlexOptions(autoFlexTagNoFlatten),
+	}
 
 	for _, optFn := range optFns {
 		optFn(flattener)
@@ -44,7 +47,13 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 	return diags
 }
 
-type autoFlattener struct{}
+type autoFlattener struct {
+	autoFlexOptions
+}
+
+func (flattener autoFlattener) getOptions() autoFlexOptions {
+	return flattener.autoFlexOptions
+}
 
 // convert converts a single AWS API value to its Plugin Framework equivalent.
 func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
@@ -91,7 +100,12 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
+		if _, ok := tTo.(basetypes.StringTypable); ok {
+			diags.Append(flattener.document(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+
+		diags.Append(flattener.union(ctx, vFrom, tTo, vTo)...)
 		return diags
 	}
 
@@ -500,8 +514,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
-		return diags
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			//
+			// []interface -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -791,6 +810,190 @@ func (flattener autoFlattener) sliceOfStructNestedObjectCollection(ctx context.C
 	return diags
 }
 
+// document copies an AWS API Smithy document value to a compatible Plugin Framework value.
+func (flattener autoFlattener) document(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch tTo := tTo.(type) {
+	case basetypes.StringTypable:
+		stringValue := types.StringNull()
+		if !vFrom.IsNil() {
+			document, ok := vFrom.Interface().(smithydocument.Marshaler)
+			if !ok {
+				break
+			}
+
+			json, err := document.MarshalSmithyDocument()
+			if err != nil {
+				diags.AddError("AutoFlEx", fmt.Sprintf("marshaling Smithy document: %s", err))
+				return diags
+			}
+
+			stringValue = types.StringValue(string(json))
+		}
+		v, d := tTo.ValueFromString(ctx, stringValue)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		//
+		// Smithy document -> types.String.
+		//
+		vTo.Set(reflect.ValueOf(v))
+		return diags
+	}
+
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Kind(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
+// union copies an AWS API Smithy union value to a compatible Plugin Framework value.
+func (flattener autoFlattener) union(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
+		//
+		// interface -> types.List(OfObject) or types.Object.
+		//
+		if vFrom.IsNil() {
+			val, d := tTo.NullValue(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+
+		to, d := flattener.unionToObjectPtr(ctx, vFrom, tTo)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Set the target structure as a nested Object.
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Kind(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
+// sliceOfUnionNestedObjectCollection copies an AWS API []Smithy union value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionNestedObjectCollection(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and flatten each element.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := flattener.unionToObjectPtr(ctx, vFrom.Index(i), tTo)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// unionToObjectPtr returns a new object pointer (Go *struct) with the field corresponding to the specified AWS API Smithy union member set.
+// Member types are named <union>Member<field>, e.g. PolicyDefinitionMemberStatic. All other fields are null.
+func (flattener autoFlattener) unionToObjectPtr(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	diags.Append(fwtypes.NullOutObjectPtrFields(ctx, to)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Get the union member's underlying struct.
+	member := vFrom
+	for member.Kind() == reflect.Interface || member.Kind() == reflect.Ptr {
+		if member.IsNil() {
+			return to, diags
+		}
+		member = member.Elem()
+	}
+
+	memberName, ok := strings.CutPrefix(member.Type().Name(), vFrom.Type().Name()+"Member")
+	if !ok || member.Kind() != reflect.Struct || !member.FieldByName("Value").IsValid() {
+		// e.g. UnknownUnionMember.
+		tflog.Info(ctx, "AutoFlex Flatten; unsupported Smithy union member", map[string]interface{}{
+			"from": member.Type().String(),
+		})
+
+		return to, diags
+	}
+
+	toFieldVal := findFieldFuzzy(ctx, memberName, reflect.ValueOf(to).Elem(), member)
+	if !toFieldVal.IsValid() || !toFieldVal.CanSet() {
+		tflog.Info(ctx, "AutoFlex Flatten; no field for Smithy union member", map[string]interface{}{
+			"from": member.Type().String(),
+		})
+
+		return to, diags
+	}
+
+	diags.Append(flattener.convert(ctx, member.FieldByName("Value"), toFieldVal)...)
+	if diags.HasError() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", memberName))
+		return nil, diags
+	}
+
+	return to, diags
+}
+
 // blockKeyMapSet takes a struct and assigns the value of the `key`
 func blockKeyMapSet(to any, key reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
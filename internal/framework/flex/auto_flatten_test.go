@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -891,6 +892,169 @@ func TestFlattenComplexSingleNestedBlock(t *testing.T) {
 	runAutoFlattenTestCases(ctx, t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "primitive member",
+			Source:   &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberAttr1{Value: "a"}},
+			Target:   &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				Attr1:  types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+		},
+		{
+			TestName: "nested object member",
+			Source:   &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberNested{Value: TestFlexAWS01{Field1: "b"}}},
+			Target:   &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				Attr1:  types.StringNull(),
+				Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+			})},
+		},
+		{
+			TestName:   "nil union",
+			Source:     &TestFlexUnionAWS01{},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+		},
+		{
+			TestName: "slice of unions",
+			Source: &TestFlexUnionAWS02{Field1: []TestFlexUnionAWS{
+				&TestFlexUnionAWSMemberAttr1{Value: "a"},
+				&TestFlexUnionAWSMemberNested{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+			Target: &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{
+				{
+					Attr1:  types.StringValue("a"),
+					Nested: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				},
+				{
+					Attr1:  types.StringNull(),
+					Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+				},
+			})},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "JSON object",
+			Source: &TestFlexSmithyDocumentAWS01{
+				Field1: newTestFlexSmithyDocument(map[string]any{"field1": "a", "field2": []any{1, 2}}),
+			},
+			Target: &TestFlexSmithyDocumentTF01{},
+			WantTarget: &TestFlexSmithyDocumentTF01{
+				Field1: fwtypes.SmithyJSONValue(`{"field1":"a","field2":[1,2]}`, newTestFlexSmithyDocument),
+			},
+		},
+		{
+			TestName:   "nil document",
+			Source:     &TestFlexSmithyDocumentAWS01{},
+			Target:     &TestFlexSmithyDocumentTF01{},
+			WantTarget: &TestFlexSmithyDocumentTF01{Field1: fwtypes.SmithyJSONNull(newTestFlexSmithyDocument)},
+		},
+		{
+			TestName:   "String target",
+			Source:     &TestFlexSmithyDocumentAWS01{Field1: newTestFlexSmithyDocument([]any{"a", "b"})},
+			Target:     &TestFlexSmithyDocumentTF02{},
+			WantTarget: &TestFlexSmithyDocumentTF02{Field1: types.StringValue(`["a","b"]`)},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testTime := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	dateConverter := WithConverter(func(ctx context.Context, from time.Time) (types.String, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		if from.IsZero() {
+			return types.StringNull(), diags
+		}
+
+		return types.StringValue(from.Format(time.DateOnly)), diags
+	})
+	enumConverter := WithConverter(func(ctx context.Context, from int32) (fwtypes.StringEnum[TestEnum], diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		if values := TestEnum("").Values(); from >= 0 && int(from) < len(values) {
+			return fwtypes.StringEnumValue(values[from]), diags
+		}
+
+		diags.AddError("invalid enum", fmt.Sprint(from))
+		return fwtypes.StringEnumNull[TestEnum](), diags
+	})
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "autoflex struct tags",
+			Source: &TestFlexTagsAWS01{
+				Field1: "x",
+				Field2: "b",
+				Field3: "c",
+				Field4: "d",
+				Other1: "a",
+			},
+			Target: &TestFlexTagsTF01{},
+			WantTarget: &TestFlexTagsTF01{
+				Field1: types.StringValue("a"),
+				Field2: types.StringNull(),
+				Field3: types.StringNull(),
+				Field4: types.StringValue("d"),
+			},
+		},
+		{
+			TestName:   "field name mapping",
+			Options:    []AutoFlexOptionsFunc{WithFieldNameMapping("Field1", "Other1")},
+			Source:     &TestFlexOtherAWS01{Other1: "a"},
+			Target:     &TestFlexTF01{},
+			WantTarget: &TestFlexTF01{Field1: types.StringValue("a")},
+		},
+		{
+			TestName:   "ignored field names",
+			Options:    []AutoFlexOptionsFunc{WithIgnoredFieldNames("Field1")},
+			Source:     &TestFlexAWS01{Field1: "a"},
+			Target:     &TestFlexTF01{},
+			WantTarget: &TestFlexTF01{},
+		},
+		{
+			TestName: "converters",
+			Options:  []AutoFlexOptionsFunc{dateConverter, enumConverter},
+			Source:   &TestFlexConvAWS01{Field1: testTime, Field2: 1},
+			Target:   &TestFlexConvTF01{},
+			WantTarget: &TestFlexConvTF01{
+				Field1: types.StringValue("2024-03-01"),
+				Field2: fwtypes.StringEnumValue(TestEnumList),
+			},
+		},
+		{
+			TestName: "converter error",
+			Options:  []AutoFlexOptionsFunc{dateConverter, enumConverter},
+			Source:   &TestFlexConvAWS01{Field1: testTime, Field2: 5},
+			Target:   &TestFlexConvTF01{},
+			WantErr:  true,
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
 func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
@@ -904,7 +1068,7 @@ func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFl
 				testCtx = testCase.Context
 			}
 
-			err := Flatten(testCtx, testCase.Source, testCase.Target)
+			err := Flatten(testCtx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
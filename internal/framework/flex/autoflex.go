@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	pluralize "github.com/gertd/go-pluralize"
@@ -27,11 +28,138 @@ const (
 // autoFlexer is the interface implemented by an auto-flattener or expander.
 type autoFlexer interface {
 	convert(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	getOptions() autoFlexOptions
 }
 
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(autoFlexer)
 
+// autoFlexOptions contains options common to auto-flatteners and expanders.
+type autoFlexOptions struct {
+	converters        map[converterKey]converter
+	fieldNameMappings map[string]string   // Source field name to target field name.
+	ignoredFieldNames map[string]struct{} // Source or target field names.
+	omitTagOption     string              // `autoflex` struct tag option that omits a field from this conversion.
+}
+
+func newAutoFlexOptions(omitTagOption string) autoFlexOptions {
+	return autoFlexOptions{
+		converters:        make(map[converterKey]converter),
+		fieldNameMappings: make(map[string]string),
+		ignoredFieldNames: make(map[string]struct{}),
+		omitTagOption:     omitTagOption,
+	}
+}
+
+// converterKey identifies the source and target types of a converter.
+type converterKey struct {
+	from, to reflect.Type
+}
+
+// converter converts a source value to a target value.
+type converter func(context.Context, reflect.Value) (reflect.Value, diag.Diagnostics)
+
+// flexerOptions returns a pointer to the specified auto-flattener's or expander's options so that they can be modified.
+func flexerOptions(flexer autoFlexer) *autoFlexOptions {
+	switch flexer := flexer.(type) {
+	case *autoExpander:
+		return &flexer.autoFlexOptions
+	case *autoFlattener:
+		return &flexer.autoFlexOptions
+	}
+
+	return nil
+}
+
+// WithConverter is an AutoFlEx option that registers a function converting values of type From to values of type To.
+// The converter is used for any source struct field of type From whose corresponding target field is of type To,
+// including null and unknown Plugin Framework values, in place of the default conversion.
+// A converter only applies in the direction matching its types, e.g.
+//
+//	flex.WithConverter(func(ctx context.Context, from types.String) (time.Time, diag.Diagnostics) { ... }) // Expand
+//	flex.WithConverter(func(ctx context.Context, from time.Time) (types.String, diag.Diagnostics) { ... }) // Flatten
+func WithConverter[From, To any](f func(context.Context, From) (To, diag.Diagnostics)) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		opts := flexerOptions(flexer)
+		if opts == nil {
+			return
+		}
+
+		key := converterKey{
+			from: reflect.TypeOf((*From)(nil)).Elem(),
+			to:   reflect.TypeOf((*To)(nil)).Elem(),
+		}
+		opts.converters[key] = func(ctx context.Context, vFrom reflect.Value) (reflect.Value, diag.Diagnostics) {
+			to, diags := f(ctx, vFrom.Interface().(From))
+
+			return reflect.ValueOf(&to).Elem(), diags
+		}
+	}
+}
+
+// WithFieldNameMapping is an AutoFlEx option that maps the named Terraform resource model field to the named AWS API field.
+// It applies to both Expand and Flatten.
+func WithFieldNameMapping(tfFieldName, apiFieldName string) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		switch flexer.(type) {
+		case *autoExpander:
+			flexerOptions(flexer).fieldNameMappings[tfFieldName] = apiFieldName
+		case *autoFlattener:
+			flexerOptions(flexer).fieldNameMappings[apiFieldName] = tfFieldName
+		}
+	}
+}
+
+// WithIgnoredFieldNames is an AutoFlEx option that ignores the named fields in either the source or target structures.
+func WithIgnoredFieldNames(fieldNames ...string) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		opts := flexerOptions(flexer)
+		if opts == nil {
+			return
+		}
+
+		for _, v := range fieldNames {
+			opts.ignoredFieldNames[v] = struct{}{}
+		}
+	}
+}
+
+const (
+	autoFlexTagKey             = "autoflex"
+	autoFlexTagNoExpand        = "noexpand"
+	autoFlexTagNoFlatten       = "noflatten"
+	autoFlexTagIgnoreFieldName = "-"
+)
+
+// autoFlexTag is the parsed value of a struct field's `autoflex` tag.
+// The tag's value is an optional corresponding field name followed by comma-separated options, e.g.
+//
+//	Field1 types.String `tfsdk:"field1" autoflex:"ApiField1"`     // Maps to AWS API field ApiField1.
+//	Field2 types.String `tfsdk:"field2" autoflex:"-"`             // Ignored.
+//	Field3 types.String `tfsdk:"field3" autoflex:",noflatten"`    // Ignored by Flatten.
+type autoFlexTag struct {
+	fieldName string
+	options   []string
+}
+
+func parseAutoFlexTag(field reflect.StructField) autoFlexTag {
+	name, options, _ := strings.Cut(field.Tag.Get(autoFlexTagKey), ",")
+
+	tag := autoFlexTag{
+		fieldName: name,
+	}
+	if options != "" {
+		tag.options = strings.Split(options, ",")
+	}
+
+	return tag
+}
+
+// omit returns whether the tagged field is omitted from a conversion with the specified omit option.
+func (t autoFlexTag) omit(omitTagOption string) bool {
+	return t.fieldName == autoFlexTagIgnoreFieldName || slices.Contains(t.options, omitTagOption)
+}
+
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -86,6 +214,8 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		return diags
 	}
 
+	opts := flexer.getOptions()
+
 	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
 		field := typFrom.Field(i)
 		if field.PkgPath != "" {
@@ -98,15 +228,44 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		if fieldName == MapBlockKey {
 			continue
 		}
+		if _, ok := opts.ignoredFieldNames[fieldName]; ok {
+			continue
+		}
+		tag := parseAutoFlexTag(field)
+		if tag.omit(opts.omitTagOption) {
+			continue
+		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom)
-		if !toFieldVal.IsValid() {
+		toFieldName := findFieldName(ctx, fieldName, tag, valTo, valFrom, opts)
+		toField, ok := valTo.Type().FieldByName(toFieldName)
+		if !ok {
 			continue // Corresponding field not found in to.
 		}
+		if _, ok := opts.ignoredFieldNames[toField.Name]; ok {
+			continue
+		}
+		if toTag := parseAutoFlexTag(toField); toTag.omit(opts.omitTagOption) {
+			continue
+		} else if toTag.fieldName != "" && toTag.fieldName != fieldName {
+			continue // Corresponding field is explicitly mapped to another field.
+		}
+		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if !toFieldVal.CanSet() {
 			continue // Corresponding field value can't be changed.
 		}
 
+		if f, ok := opts.converters[converterKey{from: field.Type, to: toField.Type}]; ok {
+			v, d := f(ctx, valFrom.Field(i))
+			diags.Append(d...)
+			if diags.HasError() {
+				diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
+				return diags
+			}
+
+			toFieldVal.Set(v)
+			continue
+		}
+
 		diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
 		if diags.HasError() {
 			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
@@ -117,10 +276,34 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	return diags
 }
 
+// findFieldName returns the name of the field in struct `valTo` corresponding to field `fieldNameFrom` in struct `valFrom`.
+// Explicit mappings, from `autoflex` struct tags or options, take precedence over fuzzy matching.
+func findFieldName(ctx context.Context, fieldNameFrom string, tagFrom autoFlexTag, valTo, valFrom reflect.Value, opts autoFlexOptions) string {
+	if tagFrom.fieldName != "" {
+		return tagFrom.fieldName
+	}
+
+	if v, ok := opts.fieldNameMappings[fieldNameFrom]; ok {
+		return v
+	}
+
+	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+		if field := typTo.Field(i); field.PkgPath == "" && parseAutoFlexTag(field).fieldName == fieldNameFrom {
+			return field.Name
+		}
+	}
+
+	return findFieldNameFuzzy(ctx, fieldNameFrom, valTo, valFrom)
+}
+
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value) reflect.Value {
+	return valTo.FieldByName(findFieldNameFuzzy(ctx, fieldNameFrom, valTo, valFrom))
+}
+
+func findFieldNameFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value) string {
 	// first precedence is exact match (case sensitive)
 	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
-		return v
+		return fieldNameFrom
 	}
 
 	// If a "from" field fuzzy matches a "to" field, we are certain the fuzzy match
@@ -141,20 +324,20 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		}
 		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
 			// probably could assume validity here since reflect gave the field name
-			return v
+			return fieldNameTo
 		}
 	}
 
 	// third precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
 		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
-			return v
+			return plural.Plural(fieldNameFrom)
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
 		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
-			return v
+			return plural.Singular(fieldNameFrom)
 		}
 	}
 
@@ -165,14 +348,14 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 			// so it will only recurse once
 			ctx = context.WithValue(ctx, ResourcePrefixRecurse, true)
 			if strings.HasPrefix(fieldNameFrom, v) {
-				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), valTo, valFrom)
+				return findFieldNameFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), valTo, valFrom)
 			}
-			return findFieldFuzzy(ctx, v+fieldNameFrom, valTo, valFrom)
+			return findFieldNameFuzzy(ctx, v+fieldNameFrom, valTo, valFrom)
 		}
 	}
 
-	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	// no finds, fuzzy or otherwise - return the unmatched name
+	return fieldNameFrom
 }
 
 func fieldExistsInStruct(field string, str reflect.Value) bool {
@@ -4,8 +4,10 @@
 package flex
 
 import (
+	"encoding/json"
 	"time"
 
+	smithydocument "github.com/aws/smithy-go/document"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -311,3 +313,101 @@ type TestFlexMapBlockKeyTF05 struct {
 	Attr1       types.String                 `tfsdk:"attr1"`
 	Attr2       types.String                 `tfsdk:"attr2"`
 }
+
+// Smithy union types.
+type TestFlexUnionAWS interface {
+	isTestFlexUnionAWS()
+}
+
+type TestFlexUnionAWSMemberAttr1 struct {
+	Value string
+}
+
+func (*TestFlexUnionAWSMemberAttr1) isTestFlexUnionAWS() {}
+
+type TestFlexUnionAWSMemberNested struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexUnionAWSMemberNested) isTestFlexUnionAWS() {}
+
+type TestFlexUnionTF01 struct {
+	Attr1  types.String                                  `tfsdk:"attr1"`
+	Nested fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"nested"`
+}
+
+type TestFlexUnionTF02 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF01] `tfsdk:"field1"`
+}
+type TestFlexUnionAWS01 struct {
+	Field1 TestFlexUnionAWS
+}
+type TestFlexUnionAWS02 struct {
+	Field1 []TestFlexUnionAWS
+}
+
+// `autoflex` struct tags.
+type TestFlexTagsTF01 struct {
+	Field1 types.String `tfsdk:"field1" autoflex:"Other1"`
+	Field2 types.String `tfsdk:"field2" autoflex:"-"`
+	Field3 types.String `tfsdk:"field3" autoflex:",noflatten"`
+	Field4 types.String `tfsdk:"field4" autoflex:",noexpand"`
+}
+type TestFlexTagsAWS01 struct {
+	Field1 string
+	Field2 string
+	Field3 string
+	Field4 string
+	Other1 string
+}
+
+type TestFlexOtherAWS01 struct {
+	Other1 string
+}
+
+// Converters.
+type TestFlexConvTF01 struct {
+	Field1 types.String                 `tfsdk:"field1"`
+	Field2 fwtypes.StringEnum[TestEnum] `tfsdk:"field2"`
+}
+type TestFlexConvAWS01 struct {
+	Field1 time.Time
+	Field2 int32
+}
+
+// Smithy document types.
+type TestFlexSmithyDocument interface {
+	smithydocument.Marshaler
+	smithydocument.Unmarshaler
+}
+
+type TestFlexSmithyDocumentValue struct {
+	Value any
+}
+
+func newTestFlexSmithyDocument(v any) TestFlexSmithyDocument {
+	return &TestFlexSmithyDocumentValue{Value: v}
+}
+
+func (d *TestFlexSmithyDocumentValue) MarshalSmithyDocument() ([]byte, error) {
+	return json.Marshal(d.Value)
+}
+
+func (d *TestFlexSmithyDocumentValue) UnmarshalSmithyDocument(v any) error {
+	b, err := json.Marshal(d.Value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+type TestFlexSmithyDocumentTF01 struct {
+	Field1 fwtypes.SmithyJSON[TestFlexSmithyDocument] `tfsdk:"field1"`
+}
+type TestFlexSmithyDocumentTF02 struct {
+	Field1 types.String `tfsdk:"field1"`
+}
+type TestFlexSmithyDocumentAWS01 struct {
+	Field1 TestFlexSmithyDocument
+}
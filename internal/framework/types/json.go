@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.TypeWithValidate                     = (*jsonType)(nil)
+	_ basetypes.StringTypable                    = (*jsonType)(nil)
+	_ basetypes.StringValuable                   = (*JSON)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSON)(nil)
+)
+
+// jsonType is the type of a JSON string attribute.
+// Semantically equivalent JSON values, e.g. those differing only in whitespace or object key order, are equal.
+type jsonType struct {
+	basetypes.StringType
+}
+
+var (
+	JSONType = jsonType{}
+)
+
+func (t jsonType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonType) String() string {
+	return "JSONType"
+}
+
+func (t jsonType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return JSONNull(), diags
+	}
+	if in.IsUnknown() {
+		return JSONUnknown(), diags
+	}
+
+	return JSON{StringValue: in}, diags
+}
+
+func (t jsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonType) ValueType(context.Context) attr.Value {
+	return JSON{}
+}
+
+func (t jsonType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return validateJSONString(in, path)
+}
+
+func JSONNull() JSON {
+	return JSON{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONUnknown() JSON {
+	return JSON{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONValue(value string) JSON {
+	return JSON{StringValue: basetypes.NewStringValue(value)}
+}
+
+type JSON struct {
+	basetypes.StringValue
+}
+
+func (v JSON) Equal(o attr.Value) bool {
+	other, ok := o.(JSON)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSON) Type(context.Context) attr.Type {
+	return JSONType
+}
+
+func (v JSON) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSON)
+
+	if !ok {
+		return false, diags
+	}
+
+	return jsonStringsEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// validateJSONString returns an error diagnostic if the specified Terraform value is not a valid JSON string.
+func validateJSONString(in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Terraform Value",
+			"An unexpected error occurred while attempting to convert a Terraform value to a string. "+
+				"This generally is an issue with the provider schema implementation. "+
+				"Please contact the provider developers.\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if !json.Valid([]byte(value)) {
+		diags.AddAttributeError(
+			path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Given Value: "+value+"\n",
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// See verify.JSONStringsEqual, which can't be called because of import cycles.
+func jsonStringsEqual(s1, s2 string) bool {
+	b1 := bytes.NewBufferString("")
+	if err := json.Compact(b1, []byte(s1)); err != nil {
+		return false
+	}
+
+	b2 := bytes.NewBufferString("")
+	if err := json.Compact(b2, []byte(s2)); err != nil {
+		return false
+	}
+
+	return jsonBytesEqual(b1.Bytes(), b2.Bytes())
+}
+
+// See verify.JSONBytesEqual.
+func jsonBytesEqual(b1, b2 []byte) bool {
+	var o1 any
+	if err := json.Unmarshal(b1, &o1); err != nil {
+		return false
+	}
+
+	var o2 any
+	if err := json.Unmarshal(b2, &o2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o1, o2)
+}
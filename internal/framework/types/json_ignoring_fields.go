@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+var (
+	_ xattr.TypeWithValidate                     = (*jsonIgnoringFieldsType)(nil)
+	_ basetypes.StringTypable                    = (*jsonIgnoringFieldsType)(nil)
+	_ basetypes.StringValuable                   = (*JSONIgnoringFields)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONIgnoringFields)(nil)
+)
+
+// jsonIgnoringFieldsType is the type of a JSON string attribute in which some fields, typically those set by AWS, are ignored.
+// JSON values that are semantically equivalent once the ignored fields have been removed are equal.
+type jsonIgnoringFieldsType struct {
+	basetypes.StringType
+	fields []string
+}
+
+// JSONIgnoringFieldsType returns the type of a JSON string attribute in which the specified fields are ignored.
+// Fields are specified as they appear in the JSON string, including quotes, e.g.
+//
+//	fwtypes.JSONIgnoringFieldsType(`"plugins"`)
+//
+// See verify.SuppressEquivalentJSONRemovingFieldsDiffs.
+func JSONIgnoringFieldsType(fields ...string) jsonIgnoringFieldsType {
+	return jsonIgnoringFieldsType{fields: fields}
+}
+
+func (t jsonIgnoringFieldsType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonIgnoringFieldsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType) && slices.Equal(t.fields, other.fields)
+}
+
+func (t jsonIgnoringFieldsType) String() string {
+	return fmt.Sprintf("JSONIgnoringFieldsType[%s]", strings.Join(t.fields, ", "))
+}
+
+func (t jsonIgnoringFieldsType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return JSONIgnoringFieldsNull(t.fields...), diags
+	}
+	if in.IsUnknown() {
+		return JSONIgnoringFieldsUnknown(t.fields...), diags
+	}
+
+	return JSONIgnoringFields{StringValue: in, fields: t.fields}, diags
+}
+
+func (t jsonIgnoringFieldsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonIgnoringFieldsType) ValueType(context.Context) attr.Value {
+	return JSONIgnoringFields{fields: t.fields}
+}
+
+func (t jsonIgnoringFieldsType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return validateJSONString(in, path)
+}
+
+func JSONIgnoringFieldsNull(fields ...string) JSONIgnoringFields {
+	return JSONIgnoringFields{StringValue: basetypes.NewStringNull(), fields: fields}
+}
+
+func JSONIgnoringFieldsUnknown(fields ...string) JSONIgnoringFields {
+	return JSONIgnoringFields{StringValue: basetypes.NewStringUnknown(), fields: fields}
+}
+
+func JSONIgnoringFieldsValue(value string, fields ...string) JSONIgnoringFields {
+	return JSONIgnoringFields{StringValue: basetypes.NewStringValue(value), fields: fields}
+}
+
+type JSONIgnoringFields struct {
+	basetypes.StringValue
+	fields []string
+}
+
+func (v JSONIgnoringFields) Equal(o attr.Value) bool {
+	other, ok := o.(JSONIgnoringFields)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONIgnoringFields) Type(context.Context) attr.Type {
+	return JSONIgnoringFieldsType(v.fields...)
+}
+
+func (v JSONIgnoringFields) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONIgnoringFields)
+
+	if !ok {
+		return false, diags
+	}
+
+	// See verify.SuppressEquivalentJSONRemovingFieldsDiffs.
+	oldString, newString := v.ValueString(), newValue.ValueString()
+	if !json.Valid([]byte(oldString)) || !json.Valid([]byte(newString)) {
+		return oldString == newString, diags
+	}
+
+	oldString, newString = tfjson.RemoveFields(oldString, v.fields...), tfjson.RemoveFields(newString, v.fields...)
+
+	return jsonStringsEqual(oldString, newString), diags
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestJSONIgnoringFieldsTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid string": {
+			val: tftypes.NewValue(tftypes.String, `{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, "not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.JSONIgnoringFieldsType(`"Key2"`).Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestJSONIgnoringFieldsStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.JSONIgnoringFields
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"equals": {
+			val1:   fwtypes.JSONIgnoringFieldsValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, `"Key2"`),
+			val2:   fwtypes.JSONIgnoringFieldsValue(`{"Key2":[1,2,3],"Key1":"Value"}`, `"Key2"`),
+			equals: true,
+		},
+		"equals ignoring fields": {
+			val1:   fwtypes.JSONIgnoringFieldsValue(`{"Key1": "Value", "Key2": {"Key3": true}}`, `"Key2"`, `"Key4"`),
+			val2:   fwtypes.JSONIgnoringFieldsValue(`{"Key1": "Value", "Key4": 42}`, `"Key2"`, `"Key4"`),
+			equals: true,
+		},
+		"not equals": {
+			val1: fwtypes.JSONIgnoringFieldsValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, `"Key2"`),
+			val2: fwtypes.JSONIgnoringFieldsValue(`{"Key1": "Other", "Key2": [1, 2, 3]}`, `"Key2"`),
+		},
+		"invalid JSON": {
+			val1: fwtypes.JSONIgnoringFieldsValue(`{"Key1": "Value"}`, `"Key2"`),
+			val2: fwtypes.JSONIgnoringFieldsValue(`{"Key1": `, `"Key2"`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, expected := equals, test.equals; got != expected {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, expected)
+			}
+		})
+	}
+}
+
+func TestJSONIgnoringFieldsTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	typ := fwtypes.JSONIgnoringFieldsType(`"Key2"`)
+
+	v, diags := typ.ValueFromString(ctx, types.StringValue(`{}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	if got := v.Type(ctx); !got.Equal(typ) {
+		t.Errorf("Type() = %s, want %s", got, typ)
+	}
+	if got := v.Type(ctx); got.Equal(fwtypes.JSONIgnoringFieldsType(`"Key3"`)) {
+		t.Errorf("Type() = %s, want not equal", got)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	_ xattr.TypeWithValidate                     = (*jsonOrYAMLType)(nil)
+	_ basetypes.StringTypable                    = (*jsonOrYAMLType)(nil)
+	_ basetypes.StringValuable                   = (*JSONOrYAML)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONOrYAML)(nil)
+)
+
+// jsonOrYAMLType is the type of a string attribute containing either JSON or YAML, e.g. a CloudFormation template.
+// A value that looks like a JSON object is treated as JSON and any other value as YAML.
+// Semantically equivalent JSON values are equal; YAML values are equal only if they are identical.
+type jsonOrYAMLType struct {
+	basetypes.StringType
+}
+
+var (
+	JSONOrYAMLType = jsonOrYAMLType{}
+)
+
+func (t jsonOrYAMLType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonOrYAMLType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonOrYAMLType) String() string {
+	return "JSONOrYAMLType"
+}
+
+func (t jsonOrYAMLType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return JSONOrYAMLNull(), diags
+	}
+	if in.IsUnknown() {
+		return JSONOrYAMLUnknown(), diags
+	}
+
+	return JSONOrYAML{StringValue: in}, diags
+}
+
+func (t jsonOrYAMLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonOrYAMLType) ValueType(context.Context) attr.Value {
+	return JSONOrYAML{}
+}
+
+func (t jsonOrYAMLType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Terraform Value",
+			"An unexpected error occurred while attempting to convert a Terraform value to a string. "+
+				"This generally is an issue with the provider schema implementation. "+
+				"Please contact the provider developers.\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if looksLikeJSONString(value) {
+		if !json.Valid([]byte(value)) {
+			diags.AddAttributeError(
+				path,
+				"Invalid JSON String Value",
+				"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+					"Path: "+path.String()+"\n"+
+					"Given Value: "+value+"\n",
+			)
+			return diags
+		}
+
+		return diags
+	}
+
+	var v any
+	if err := yaml.Unmarshal([]byte(value), &v); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid YAML String Value",
+			"A string value was provided that is not valid YAML.\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Given Value: "+value+"\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func JSONOrYAMLNull() JSONOrYAML {
+	return JSONOrYAML{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONOrYAMLUnknown() JSONOrYAML {
+	return JSONOrYAML{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONOrYAMLValue(value string) JSONOrYAML {
+	return JSONOrYAML{StringValue: basetypes.NewStringValue(value)}
+}
+
+type JSONOrYAML struct {
+	basetypes.StringValue
+}
+
+func (v JSONOrYAML) Equal(o attr.Value) bool {
+	other, ok := o.(JSONOrYAML)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONOrYAML) Type(context.Context) attr.Type {
+	return JSONOrYAMLType
+}
+
+func (v JSONOrYAML) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONOrYAML)
+
+	if !ok {
+		return false, diags
+	}
+
+	// See verify.SuppressEquivalentJSONOrYAMLDiffs.
+	oldString, newString := v.ValueString(), newValue.ValueString()
+	if looksLikeJSONString(oldString) && looksLikeJSONString(newString) {
+		return jsonStringsEqual(oldString, newString), diags
+	}
+
+	return oldString == newString, diags
+}
+
+// looksLikeJSONString returns whether the specified string looks like a JSON object.
+// See verify.looksLikeJSONString.
+func looksLikeJSONString(s string) bool {
+	return strings.HasPrefix(strings.TrimLeft(s, " \t\n\f\r"), "{")
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestJSONOrYAMLTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid JSON": {
+			val: tftypes.NewValue(tftypes.String, `{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"invalid JSON": {
+			val:         tftypes.NewValue(tftypes.String, `{"Key1": `),
+			expectError: true,
+		},
+		"valid YAML": {
+			val: tftypes.NewValue(tftypes.String, "Key1: Value\nKey2:\n  - 1\n  - 2\n"),
+		},
+		"invalid YAML": {
+			val:         tftypes.NewValue(tftypes.String, "Key1: Value\n Key2: [1, 2"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.JSONOrYAMLType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestJSONOrYAMLStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.JSONOrYAML
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"JSON equals": {
+			val1:   fwtypes.JSONOrYAMLValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`),
+			val2:   fwtypes.JSONOrYAMLValue(`  {"Key2":[1,2,3],"Key1":"Value"}`),
+			equals: true,
+		},
+		"JSON not equals": {
+			val1: fwtypes.JSONOrYAMLValue(`{"Key1": "Value"}`),
+			val2: fwtypes.JSONOrYAMLValue(`{"Key1": "Other"}`),
+		},
+		"YAML equals": {
+			val1:   fwtypes.JSONOrYAMLValue("Key1: Value\n"),
+			val2:   fwtypes.JSONOrYAMLValue("Key1: Value\n"),
+			equals: true,
+		},
+		"YAML not equals": {
+			val1: fwtypes.JSONOrYAMLValue("Key1: Value\n"),
+			val2: fwtypes.JSONOrYAMLValue("Key1:  Value\n"),
+		},
+		"JSON and YAML": {
+			val1: fwtypes.JSONOrYAMLValue(`{"Key1": "Value"}`),
+			val2: fwtypes.JSONOrYAMLValue("Key1: Value\n"),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, expected := equals, test.equals; got != expected {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, expected)
+			}
+		})
+	}
+}
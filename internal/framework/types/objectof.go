@@ -139,9 +139,14 @@ func objectTypeNewObjectPtr[T any](context.Context) (*T, diag.Diagnostics) {
 }
 
 // NullOutObjectPtrFields sets all applicable fields of the specified object pointer to their null values.
-func NullOutObjectPtrFields[T any](ctx context.Context, t *T) diag.Diagnostics {
+func NullOutObjectPtrFields(ctx context.Context, t any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	val := reflect.ValueOf(t)
+
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return diags
+	}
+
 	typ := val.Type().Elem()
 
 	if typ.Kind() != reflect.Struct {
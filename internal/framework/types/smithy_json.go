@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	smithydocument "github.com/aws/smithy-go/document"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.TypeWithValidate                     = (*smithyJSONType[smithydocument.Marshaler])(nil)
+	_ basetypes.StringTypable                    = (*smithyJSONType[smithydocument.Marshaler])(nil)
+	_ basetypes.StringValuable                   = (*SmithyJSON[smithydocument.Marshaler])(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*SmithyJSON[smithydocument.Marshaler])(nil)
+	_ SmithyDocumentValue                        = (*SmithyJSON[smithydocument.Marshaler])(nil)
+)
+
+// SmithyDocumentValue is a value that can be converted to a Smithy document.
+// It's used inside AutoFlEx.
+type SmithyDocumentValue interface {
+	ToSmithyDocument(context.Context) (smithydocument.Marshaler, diag.Diagnostics)
+}
+
+// smithyJSONType is the type of a JSON string attribute whose value corresponds to an AWS API Smithy document of type T.
+type smithyJSONType[T smithydocument.Marshaler] struct {
+	basetypes.StringType
+	f func(any) T
+}
+
+// SmithyJSONType returns the type of a JSON string attribute whose value corresponds to an AWS API Smithy document of type T,
+// e.g. `fwtypes.SmithyJSONType(ctx, document.NewLazyDocument)`.
+// `f` constructs a Smithy document from a decoded JSON value; it is typically the service's `document.NewLazyDocument` function.
+func SmithyJSONType[T smithydocument.Marshaler](_ context.Context, f func(any) T) smithyJSONType[T] {
+	return smithyJSONType[T]{f: f}
+}
+
+func (t smithyJSONType[T]) Equal(o attr.Type) bool {
+	other, ok := o.(smithyJSONType[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t smithyJSONType[T]) String() string {
+	var zero T
+	return fmt.Sprintf("SmithyJSONType[%T]", zero)
+}
+
+func (t smithyJSONType[T]) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return SmithyJSONNull[T](t.f), diags
+	}
+	if in.IsUnknown() {
+		return SmithyJSONUnknown[T](t.f), diags
+	}
+
+	return SmithyJSON[T]{StringValue: in, f: t.f}, diags
+}
+
+func (t smithyJSONType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t smithyJSONType[T]) ValueType(context.Context) attr.Value {
+	return SmithyJSON[T]{f: t.f}
+}
+
+func (t smithyJSONType[T]) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return validateJSONString(in, path)
+}
+
+func SmithyJSONNull[T smithydocument.Marshaler](f func(any) T) SmithyJSON[T] {
+	return SmithyJSON[T]{StringValue: basetypes.NewStringNull(), f: f}
+}
+
+func SmithyJSONUnknown[T smithydocument.Marshaler](f func(any) T) SmithyJSON[T] {
+	return SmithyJSON[T]{StringValue: basetypes.NewStringUnknown(), f: f}
+}
+
+func SmithyJSONValue[T smithydocument.Marshaler](value string, f func(any) T) SmithyJSON[T] {
+	return SmithyJSON[T]{StringValue: basetypes.NewStringValue(value), f: f}
+}
+
+type SmithyJSON[T smithydocument.Marshaler] struct {
+	basetypes.StringValue
+	f func(any) T
+}
+
+func (v SmithyJSON[T]) Equal(o attr.Value) bool {
+	other, ok := o.(SmithyJSON[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SmithyJSON[T]) Type(context.Context) attr.Type {
+	return smithyJSONType[T]{f: v.f}
+}
+
+func (v SmithyJSON[T]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SmithyJSON[T])
+
+	if !ok {
+		return false, diags
+	}
+
+	return jsonStringsEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// ValueInterface returns the Smithy document corresponding to the JSON value.
+func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var zero T
+
+	if v.IsNull() || v.IsUnknown() {
+		return zero, diags
+	}
+
+	if v.f == nil {
+		diags.AddError("Smithy document constructor", fmt.Sprintf("no constructor for %T", zero))
+		return zero, diags
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(v.ValueString()), &value); err != nil {
+		diags.AddError("JSON Unmarshal Error", err.Error())
+		return zero, diags
+	}
+
+	return v.f(value), diags
+}
+
+func (v SmithyJSON[T]) ToSmithyDocument(context.Context) (smithydocument.Marshaler, diag.Diagnostics) {
+	return v.ValueInterface()
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type testSmithyDocument struct {
+	value any
+}
+
+func newTestSmithyDocument(v any) *testSmithyDocument {
+	return &testSmithyDocument{value: v}
+}
+
+func (d *testSmithyDocument) MarshalSmithyDocument() ([]byte, error) {
+	return json.Marshal(d.value)
+}
+
+func TestSmithyJSONTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid string": {
+			val: tftypes.NewValue(tftypes.String, `{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, "not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.SmithyJSONType(ctx, newTestSmithyDocument).Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestSmithyJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.SmithyJSON[*testSmithyDocument]
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"equals": {
+			val1:   fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, newTestSmithyDocument),
+			val2:   fwtypes.SmithyJSONValue(`{"Key2":[1,2,3],"Key1":"Value"}`, newTestSmithyDocument),
+			equals: true,
+		},
+		"not equals": {
+			val1: fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, newTestSmithyDocument),
+			val2: fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [3, 2, 1]}`, newTestSmithyDocument),
+		},
+		"invalid JSON": {
+			val1: fwtypes.SmithyJSONValue(`{"Key1": "Value"}`, newTestSmithyDocument),
+			val2: fwtypes.SmithyJSONValue(`{"Key1": `, newTestSmithyDocument),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, expected := equals, test.equals; got != expected {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, expected)
+			}
+		})
+	}
+}
+
+func TestSmithyJSONValueInterface(t *testing.T) {
+	t.Parallel()
+
+	document, diags := fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, newTestSmithyDocument).ValueInterface()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	got, err := document.MarshalSmithyDocument()
+	if err != nil {
+		t.Fatalf("MarshalSmithyDocument: %s", err)
+	}
+
+	if diff := cmp.Diff(string(got), `{"Key1":"Value","Key2":[1,2,3]}`); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if document, diags := fwtypes.SmithyJSONNull(newTestSmithyDocument).ValueInterface(); diags.HasError() || document != nil {
+		t.Errorf("null ValueInterface() = %v, %#v", document, diags)
+	}
+}
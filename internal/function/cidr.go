@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// cidrSplitMaxSubnets is the maximum number of subnets that a CIDR block may be split into.
+	cidrSplitMaxSubnets = 1 << 16
+)
+
+// parseCIDRBlock parses and validates the specified IPv4 or IPv6 CIDR block.
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	if prefix.Addr().Is4() {
+		err = verify.ValidateIPv4CIDRBlock(cidr)
+	} else {
+		err = verify.ValidateIPv6CIDRBlock(cidr)
+	}
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix, nil
+}
+
+// parseCIDRBlockInFamily parses and validates the specified CIDR block,
+// ensuring that it is in the same address family as `parent`.
+func parseCIDRBlockInFamily(cidr string, parent netip.Prefix) (netip.Prefix, error) {
+	prefix, err := parseCIDRBlock(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if prefix.Addr().Is4() != parent.Addr().Is4() {
+		return netip.Prefix{}, fmt.Errorf("%q and %q are not in the same address family", cidr, parent)
+	}
+
+	return prefix, nil
+}
+
+// validateNewPrefixLength validates that a prefix of the specified length fits within `parent`.
+func validateNewPrefixLength(parent netip.Prefix, length int64) error {
+	if length < int64(parent.Bits()) || length > int64(parent.Addr().BitLen()) {
+		return fmt.Errorf("prefix length %d is not valid for %q; must be between %d and %d", length, parent, parent.Bits(), parent.Addr().BitLen())
+	}
+
+	return nil
+}
+
+// cidrSplit splits `parent` into all of the contained CIDR blocks of the specified prefix length.
+func cidrSplit(parent netip.Prefix, length int64) ([]string, error) {
+	if err := validateNewPrefixLength(parent, length); err != nil {
+		return nil, err
+	}
+
+	newBits := int(length) - parent.Bits()
+	if newBits > 16 {
+		return nil, fmt.Errorf("splitting %q into /%d blocks would produce more than %d CIDR blocks", parent, length, cidrSplitMaxSubnets)
+	}
+
+	n := 1 << newBits
+	size := blockSize(parent.Addr(), int(length))
+	start := addrToInt(parent.Addr())
+	results := make([]string, 0, n)
+
+	for i := 0; i < n; i++ {
+		v := new(big.Int).Mul(size, big.NewInt(int64(i)))
+		v.Add(v, start)
+		results = append(results, netip.PrefixFrom(intToAddr(v, parent.Addr().Is4()), int(length)).String())
+	}
+
+	return results, nil
+}
+
+// cidrNextFree returns the lowest CIDR block of the specified prefix length within `parent`
+// that does not overlap any of the `allocated` CIDR blocks.
+func cidrNextFree(parent netip.Prefix, length int64, allocated []netip.Prefix) (netip.Prefix, error) {
+	if err := validateNewPrefixLength(parent, length); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	is4 := parent.Addr().Is4()
+	size := blockSize(parent.Addr(), int(length))
+	current := addrToInt(parent.Addr())
+	end := new(big.Int).Add(current, blockSize(parent.Addr(), parent.Bits()))
+
+	for new(big.Int).Add(current, size).Cmp(end) <= 0 {
+		candidate := netip.PrefixFrom(intToAddr(current, is4), int(length))
+
+		// Find the furthest-reaching allocation that overlaps the candidate.
+		var next *big.Int
+		for _, v := range allocated {
+			if !v.Overlaps(candidate) {
+				continue
+			}
+
+			last := lastAddrInt(v)
+			if next == nil || last.Cmp(next) > 0 {
+				next = last
+			}
+		}
+
+		if next == nil {
+			return candidate, nil
+		}
+
+		// Advance past the overlapping allocation, aligned to the requested block size.
+		next.Add(next, big.NewInt(1))
+		if next.Cmp(new(big.Int).Add(current, size)) < 0 {
+			next.Add(current, size)
+		}
+		current = alignUp(next, size)
+	}
+
+	return netip.Prefix{}, fmt.Errorf("no free /%d CIDR block available in %q", length, parent)
+}
+
+// addrToInt returns the integer value of the specified IP address.
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+// intToAddr returns the IP address with the specified integer value.
+func intToAddr(v *big.Int, is4 bool) netip.Addr {
+	n := 16
+	if is4 {
+		n = 4
+	}
+
+	b := v.FillBytes(make([]byte, n))
+	addr, _ := netip.AddrFromSlice(b)
+
+	return addr
+}
+
+// blockSize returns the number of addresses in a CIDR block with the specified prefix length.
+func blockSize(addr netip.Addr, bits int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(addr.BitLen()-bits))
+}
+
+// lastAddrInt returns the integer value of the last address in the specified CIDR block.
+func lastAddrInt(prefix netip.Prefix) *big.Int {
+	v := new(big.Int).Add(addrToInt(prefix.Masked().Addr()), blockSize(prefix.Addr(), prefix.Bits()))
+
+	return v.Sub(v, big.NewInt(1))
+}
+
+// alignUp rounds `v` up to the nearest multiple of `size`.
+func alignUp(v, size *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(v, size, new(big.Int))
+	if r.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+
+	return q.Mul(q, size)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = cidrContainsFunction{}
+
+func NewCIDRContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_contains Function",
+		MarkdownDescription: "Reports whether a CIDR block contains an IP address or another CIDR block",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+			function.StringParameter{
+				Name:                "address",
+				MarkdownDescription: "IP address or CIDR block to test",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock, address string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &address))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, err := parseCIDRBlock(cidrBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	var child netip.Prefix
+	if strings.Contains(address, "/") {
+		child, err = parseCIDRBlock(address)
+	} else {
+		var addr netip.Addr
+		addr, err = netip.ParseAddr(address)
+		if err != nil {
+			err = fmt.Errorf("%q is not a valid IP address: %w", address, err)
+		} else {
+			child = netip.PrefixFrom(addr, addr.BitLen())
+		}
+	}
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	result := child.Addr().Is4() == parent.Addr().Is4() && child.Bits() >= parent.Bits() && parent.Contains(child.Addr())
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRContainsFunction_known(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock string
+		address   string
+		expected  bool
+	}{
+		"IPv4 address": {
+			cidrBlock: "10.0.0.0/16",
+			address:   "10.0.255.1",
+			expected:  true,
+		},
+		"IPv4 address outside": {
+			cidrBlock: "10.0.0.0/16",
+			address:   "10.1.0.1",
+			expected:  false,
+		},
+		"IPv4 CIDR block": {
+			cidrBlock: "10.0.0.0/16",
+			address:   "10.0.4.0/22",
+			expected:  true,
+		},
+		"IPv4 larger CIDR block": {
+			cidrBlock: "10.0.0.0/16",
+			address:   "10.0.0.0/8",
+			expected:  false,
+		},
+		"IPv6 address": {
+			cidrBlock: "2001:db8::/56",
+			address:   "2001:db8:0:ff::1",
+			expected:  true,
+		},
+		"mixed address families": {
+			cidrBlock: "10.0.0.0/8",
+			address:   "2001:db8::1",
+			expected:  false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testCIDRContainsFunctionConfig(testCase.cidrBlock, testCase.address),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("test", strconv.FormatBool(testCase.expected)),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCIDRContainsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRContainsFunctionConfig("10.0.0.0/16", "invalid"),
+				ExpectError: regexache.MustCompile("is not a valid IP address"),
+			},
+		},
+	})
+}
+
+func testCIDRContainsFunctionConfig(cidrBlock, address string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_contains(%[1]q, %[2]q)
+}
+`, cidrBlock, address)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrNextFreeFunction{}
+
+func NewCIDRNextFreeFunction() function.Function {
+	return &cidrNextFreeFunction{}
+}
+
+type cidrNextFreeFunction struct{}
+
+func (f cidrNextFreeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_next_free"
+}
+
+func (f cidrNextFreeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_next_free Function",
+		MarkdownDescription: "Finds the lowest CIDR block of a given prefix length that does not overlap any existing allocations",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block from which to allocate",
+			},
+			function.Int64Parameter{
+				Name:                "prefix_length",
+				MarkdownDescription: "Prefix length of the CIDR block to allocate",
+			},
+			function.ListParameter{
+				Name:                "allocated_cidr_blocks",
+				MarkdownDescription: "CIDR blocks that are already allocated",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f cidrNextFreeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var prefixLength int64
+	var allocatedCIDRBlocks []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &prefixLength, &allocatedCIDRBlocks))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, err := parseCIDRBlock(cidrBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	allocated := make([]netip.Prefix, 0, len(allocatedCIDRBlocks))
+	for _, v := range allocatedCIDRBlocks {
+		prefix, err := parseCIDRBlockInFamily(v, parent)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+			return
+		}
+
+		allocated = append(allocated, prefix)
+	}
+
+	result, err := cidrNextFree(parent, prefixLength, allocated)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.String()))
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRNextFreeFunction_known(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock    string
+		prefixLength int
+		allocated    []string
+		expected     string
+	}{
+		"IPv4 nothing allocated": {
+			cidrBlock:    "10.0.0.0/16",
+			prefixLength: 24,
+			expected:     "10.0.0.0/24",
+		},
+		"IPv4 gap": {
+			cidrBlock:    "10.0.0.0/16",
+			prefixLength: 24,
+			allocated:    []string{"10.0.0.0/24", "10.0.2.0/24"},
+			expected:     "10.0.1.0/24",
+		},
+		"IPv4 alignment": {
+			cidrBlock:    "10.0.0.0/16",
+			prefixLength: 22,
+			allocated:    []string{"10.0.0.0/24", "10.0.4.0/22"},
+			expected:     "10.0.8.0/22",
+		},
+		"IPv4 smaller than allocation": {
+			cidrBlock:    "10.0.0.0/16",
+			prefixLength: 26,
+			allocated:    []string{"10.0.0.0/24", "10.0.1.0/25"},
+			expected:     "10.0.1.128/26",
+		},
+		"IPv6": {
+			cidrBlock:    "2001:db8::/56",
+			prefixLength: 64,
+			allocated:    []string{"2001:db8::/64", "2001:db8:0:1::/64"},
+			expected:     "2001:db8:0:2::/64",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testCIDRNextFreeFunctionConfig(testCase.cidrBlock, testCase.prefixLength, testCase.allocated),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("test", testCase.expected),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCIDRNextFreeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock     string
+		prefixLength  int
+		allocated     []string
+		expectedError string
+	}{
+		"exhausted": {
+			cidrBlock:     "10.0.0.0/23",
+			prefixLength:  24,
+			allocated:     []string{"10.0.0.0/24", "10.0.1.0/24"},
+			expectedError: "no free /24 CIDR block",
+		},
+		"mixed address families": {
+			cidrBlock:     "10.0.0.0/16",
+			prefixLength:  24,
+			allocated:     []string{"2001:db8::/64"},
+			expectedError: "same address family",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config:      testCIDRNextFreeFunctionConfig(testCase.cidrBlock, testCase.prefixLength, testCase.allocated),
+						ExpectError: regexache.MustCompile(regexp.QuoteMeta(testCase.expectedError)),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testCIDRNextFreeFunctionConfig(cidrBlock string, prefixLength int, allocated []string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_next_free(%[1]q, %[2]d, [%[3]s])
+}
+`, cidrBlock, prefixLength, testCIDRListConfig(allocated))
+}
+
+func testCIDRListConfig(cidrBlocks []string) string {
+	quoted := make([]string, 0, len(cidrBlocks))
+	for _, v := range cidrBlocks {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+
+	return strings.Join(quoted, ", ")
+}
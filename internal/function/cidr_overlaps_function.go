@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_overlaps Function",
+		MarkdownDescription: "Reports whether two CIDR blocks overlap",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block_a",
+				MarkdownDescription: "First IPv4 or IPv6 CIDR block",
+			},
+			function.StringParameter{
+				Name:                "cidr_block_b",
+				MarkdownDescription: "Second IPv4 or IPv6 CIDR block",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlockA, cidrBlockB string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlockA, &cidrBlockB))
+	if resp.Error != nil {
+		return
+	}
+
+	a, err := parseCIDRBlock(cidrBlockA)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	b, err := parseCIDRBlock(cidrBlockB)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	// CIDR blocks in different address families never overlap.
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, a.Overlaps(b)))
+}
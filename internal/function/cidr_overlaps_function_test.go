@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDROverlapsFunction_known(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlockA string
+		cidrBlockB string
+		expected   bool
+	}{
+		"IPv4 contained": {
+			cidrBlockA: "10.0.0.0/16",
+			cidrBlockB: "10.0.1.0/24",
+			expected:   true,
+		},
+		"IPv4 disjoint": {
+			cidrBlockA: "10.0.0.0/24",
+			cidrBlockB: "10.0.1.0/24",
+			expected:   false,
+		},
+		"IPv6 contained": {
+			cidrBlockA: "2001:db8:0:1::/64",
+			cidrBlockB: "2001:db8::/56",
+			expected:   true,
+		},
+		"IPv6 disjoint": {
+			cidrBlockA: "2001:db8::/64",
+			cidrBlockB: "2001:db8:0:1::/64",
+			expected:   false,
+		},
+		"mixed address families": {
+			cidrBlockA: "10.0.0.0/8",
+			cidrBlockB: "2001:db8::/32",
+			expected:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testCIDROverlapsFunctionConfig(testCase.cidrBlockA, testCase.cidrBlockB),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("test", strconv.FormatBool(testCase.expected)),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCIDROverlapsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDROverlapsFunctionConfig("10.0.0.0/16", "invalid"),
+				ExpectError: regexache.MustCompile("is not a valid CIDR block"),
+			},
+		},
+	})
+}
+
+func testCIDROverlapsFunctionConfig(cidrBlockA, cidrBlockB string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_overlaps(%[1]q, %[2]q)
+}
+`, cidrBlockA, cidrBlockB)
+}
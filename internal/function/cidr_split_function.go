@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrSplitFunction{}
+
+func NewCIDRSplitFunction() function.Function {
+	return &cidrSplitFunction{}
+}
+
+type cidrSplitFunction struct{}
+
+func (f cidrSplitFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_split"
+}
+
+func (f cidrSplitFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_split Function",
+		MarkdownDescription: "Splits a CIDR block into all of the contained CIDR blocks of a given prefix length",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to split",
+			},
+			function.Int64Parameter{
+				Name:                "prefix_length",
+				MarkdownDescription: "Prefix length of the resulting CIDR blocks",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSplitFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var prefixLength int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &prefixLength))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, err := parseCIDRBlock(cidrBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := cidrSplit(parent, prefixLength)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSplitFunction_known(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock    string
+		prefixLength int
+		expected     string
+	}{
+		"IPv4": {
+			cidrBlock:    "10.0.0.0/16",
+			prefixLength: 18,
+			expected:     "10.0.0.0/18,10.0.64.0/18,10.0.128.0/18,10.0.192.0/18",
+		},
+		"IPv4 same length": {
+			cidrBlock:    "10.0.0.0/16",
+			prefixLength: 16,
+			expected:     "10.0.0.0/16",
+		},
+		"IPv6": {
+			cidrBlock:    "2001:db8::/62",
+			prefixLength: 64,
+			expected:     "2001:db8::/64,2001:db8:0:1::/64,2001:db8:0:2::/64,2001:db8:0:3::/64",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testCIDRSplitFunctionConfig(testCase.cidrBlock, testCase.prefixLength),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("test", testCase.expected),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCIDRSplitFunction_IPv6_56to64(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "count" {
+  value = length(provider::aws::cidr_split("2600:1f14:abc:de00::/56", 64))
+}
+
+output "last" {
+  value = provider::aws::cidr_split("2600:1f14:abc:de00::/56", 64)[255]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "256"),
+					resource.TestCheckOutput("last", "2600:1f14:abc:deff::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock     string
+		prefixLength  int
+		expectedError string
+	}{
+		"not a CIDR block": {
+			cidrBlock:     "invalid",
+			prefixLength:  24,
+			expectedError: "is not a valid CIDR block",
+		},
+		"not canonical": {
+			cidrBlock:     "10.0.0.1/16",
+			prefixLength:  24,
+			expectedError: "did you mean",
+		},
+		"prefix length too short": {
+			cidrBlock:     "10.0.0.0/16",
+			prefixLength:  8,
+			expectedError: "prefix length 8",
+		},
+		"too many CIDR blocks": {
+			cidrBlock:     "2001:db8::/32",
+			prefixLength:  64,
+			expectedError: "more than 65536",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config:      testCIDRSplitFunctionConfig(testCase.cidrBlock, testCase.prefixLength),
+						ExpectError: regexache.MustCompile(regexp.QuoteMeta(testCase.expectedError)),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testCIDRSplitFunctionConfig(cidrBlock string, prefixLength int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_split(%[1]q, %[2]d))
+}
+`, cidrBlock, prefixLength)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
+)
+
+var _ function.Function = cloudWatchEventPatternMatchesFunction{}
+
+func NewCloudWatchEventPatternMatchesFunction() function.Function {
+	return &cloudWatchEventPatternMatchesFunction{}
+}
+
+type cloudWatchEventPatternMatchesFunction struct{}
+
+func (f cloudWatchEventPatternMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cloudwatch_event_pattern_matches"
+}
+
+func (f cloudWatchEventPatternMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cloudwatch_event_pattern_matches Function",
+		MarkdownDescription: "Reports whether an EventBridge event pattern matches an event",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "event_pattern",
+				MarkdownDescription: "EventBridge event pattern",
+			},
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "JSON event to test",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cloudWatchEventPatternMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var eventPattern, event string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &eventPattern, &event))
+	if resp.Error != nil {
+		return
+	}
+
+	pattern, err := eventpattern.Parse(eventPattern)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	matched, err := pattern.MatchEvent(event)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, matched))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCloudWatchEventPatternMatchesFunction_known(t *testing.T) {
+	t.Parallel()
+
+	const event = `{"source": "aws.ec2", "detail": {"state": "running", "count": 3}}`
+
+	testCases := map[string]struct {
+		pattern  string
+		expected bool
+	}{
+		"equals": {
+			pattern:  `{"source": ["aws.ec2"], "detail": {"state": ["running"]}}`,
+			expected: true,
+		},
+		"no match": {
+			pattern:  `{"source": ["aws.s3"]}`,
+			expected: false,
+		},
+		"content filters": {
+			pattern:  `{"source": [{"prefix": "aws."}], "detail": {"state": [{"anything-but": "stopped"}], "count": [{"numeric": [">", 1, "<=", 3]}]}}`,
+			expected: true,
+		},
+		"or": {
+			pattern:  `{"$or": [{"source": ["aws.s3"]}, {"detail": {"count": [{"numeric": ["=", 3]}]}}]}`,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testCloudWatchEventPatternMatchesFunctionConfig(testCase.pattern, event),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("test", strconv.FormatBool(testCase.expected)),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCloudWatchEventPatternMatchesFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCloudWatchEventPatternMatchesFunctionConfig(`{"source": [{"contains": "ec2"}]}`, `{"source": "aws.ec2"}`),
+				ExpectError: regexache.MustCompile(`unsupported content filter \(contains\)`),
+			},
+		},
+	})
+}
+
+func testCloudWatchEventPatternMatchesFunctionConfig(pattern, event string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cloudwatch_event_pattern_matches(%[1]q, %[2]q)
+}
+`, pattern, event)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyEquivalentFunction{}
+
+func NewIAMPolicyEquivalentFunction() function.Function {
+	return &iamPolicyEquivalentFunction{}
+}
+
+type iamPolicyEquivalentFunction struct{}
+
+func (f iamPolicyEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equivalent"
+}
+
+func (f iamPolicyEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_policy_equivalent Function",
+		MarkdownDescription: "Reports whether two IAM policy documents are semantically equivalent",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy_a",
+				MarkdownDescription: "First IAM policy document",
+			},
+			function.StringParameter{
+				Name:                "policy_b",
+				MarkdownDescription: "Second IAM policy document",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policyA, policyB string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policyA, &policyB))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(policyA, policyB)))
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyEquivalentFunction_known(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policyA  string
+		policyB  string
+		expected bool
+	}{
+		"identical": {
+			policyA:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			policyB:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			expected: true,
+		},
+		"single element lists": {
+			policyA:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			policyB:  `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:*"],"Resource":["*"]}}`,
+			expected: true,
+		},
+		"different actions": {
+			policyA:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			policyB:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`,
+			expected: false,
+		},
+		"empty": {
+			policyA:  "",
+			policyB:  "{}",
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testIAMPolicyEquivalentFunctionConfig(testCase.policyA, testCase.policyB),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("test", strconv.FormatBool(testCase.expected)),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testIAMPolicyEquivalentFunctionConfig(policyA, policyB string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_equivalent(%[1]q, %[2]q)
+}
+`, policyA, policyB)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+const (
+	// iamPolicyMergeDefaultVersion matches the default `version` of the `aws_iam_policy_document` data source.
+	iamPolicyMergeDefaultVersion = "2012-10-17"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents using the same rules as the `aws_iam_policy_document` data source",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "source_policy_documents",
+				MarkdownDescription: "IAM policy documents to merge. Statement Sids must be unique across all source documents",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "override_policy_documents",
+				MarkdownDescription: "IAM policy documents to merge in order. Statements replace any earlier statement with the same Sid",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sourcePolicyDocuments, overridePolicyDocuments []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &sourcePolicyDocuments, &overridePolicyDocuments))
+	if resp.Error != nil {
+		return
+	}
+
+	mergedDoc := &iampolicy.Document{}
+
+	if err := mergedDoc.MergeSourceDocuments(sourcePolicyDocuments); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	mergedDoc.Merge(&iampolicy.Document{
+		Version: iamPolicyMergeDefaultVersion,
+	})
+
+	if err := mergedDoc.MergeOverrideDocuments(overridePolicyDocuments); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	result, err := json.MarshalIndent(mergedDoc, "", "  ")
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(result)))
+}
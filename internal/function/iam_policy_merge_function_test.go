@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_known,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("equivalent", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_duplicateSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig_duplicateSid,
+				ExpectError: regexache.MustCompile(`duplicate Sid \(S3\)`),
+			},
+		},
+	})
+}
+
+const testIAMPolicyMergeFunctionConfig_known = `
+locals {
+  source = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid      = "S3"
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "*"
+    }]
+  })
+
+  override = jsonencode({
+    Statement = [{
+      Sid      = "S3"
+      Effect   = "Deny"
+      Action   = "s3:*"
+      Resource = "*"
+    }]
+  })
+
+  expected = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid      = "S3"
+      Effect   = "Deny"
+      Action   = "s3:*"
+      Resource = "*"
+    }]
+  })
+}
+
+output "equivalent" {
+  value = provider::aws::iam_policy_equivalent(provider::aws::iam_policy_merge([local.source], [local.override]), local.expected)
+}
+`
+
+const testIAMPolicyMergeFunctionConfig_duplicateSid = `
+locals {
+  source = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid      = "S3"
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "*"
+    }]
+  })
+}
+
+output "test" {
+  value = provider::aws::iam_policy_merge([local.source, local.source], [])
+}
+`
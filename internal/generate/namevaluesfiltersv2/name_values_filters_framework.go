@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfiltersv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// FilterModel represents a single configured filter in a Terraform Plugin Framework data source.
+type FilterModel struct {
+	Name   types.String                     `tfsdk:"name"`
+	Values fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
+
+// FiltersValue is the value of a block described by Block().
+type FiltersValue = fwtypes.SetNestedObjectValueOf[FilterModel]
+
+// Block returns a Terraform Plugin Framework block that represents a set of custom filtering criteria
+// that a user can specify as input to a data source.
+// It is the Plugin Framework variant of Schema() and is conventionally included as a top-level block called "filter".
+func Block(ctx context.Context) schema.Block {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[FilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+				"values": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// NewFromFramework creates NameValuesFilters from the value of a block described by Block().
+func NewFromFramework(ctx context.Context, v FiltersValue) (NameValuesFilters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filters := make(NameValuesFilters)
+
+	if v.IsNull() || v.IsUnknown() {
+		return filters, diags
+	}
+
+	data, d := v.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, filter := range data {
+		if filter.Name.IsNull() || filter.Name.IsUnknown() {
+			continue
+		}
+
+		var values []string
+		diags.Append(filter.Values.ElementsAs(ctx, &values, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		filters.Add(map[string][]string{
+			filter.Name.ValueString(): values,
+		})
+	}
+
+	return filters, diags
+}
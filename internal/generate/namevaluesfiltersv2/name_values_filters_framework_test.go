@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfiltersv2_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfiltersv2"
+)
+
+func TestNewFromFramework(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		input namevaluesfiltersv2.FiltersValue
+		want  map[string][]string
+	}{
+		"null": {
+			input: fwtypes.NewSetNestedObjectValueOfNull[namevaluesfiltersv2.FilterModel](ctx),
+			want:  map[string][]string{},
+		},
+		"unknown": {
+			input: fwtypes.NewSetNestedObjectValueOfUnknown[namevaluesfiltersv2.FilterModel](ctx),
+			want:  map[string][]string{},
+		},
+		"filters": {
+			input: fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, []namevaluesfiltersv2.FilterModel{
+				{
+					Name:   types.StringValue("name1"),
+					Values: fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("value1")}),
+				},
+				{
+					Name:   types.StringValue("name2"),
+					Values: fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("value2a"), types.StringValue("value2b"), types.StringValue("")}),
+				},
+			}),
+			want: map[string][]string{
+				"name1": {"value1"},
+				"name2": {"value2a", "value2b"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filters, diags := namevaluesfiltersv2.NewFromFramework(ctx, testCase.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			got := filters.Map()
+			for _, v := range got {
+				slices.Sort(v)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestDocumentMergeSourceDocuments(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sources  []string
+		expected string
+		wantErr  string
+	}{
+		"empty": {
+			sources:  []string{"", ""},
+			expected: `{}`,
+		},
+		"merged": {
+			sources: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Statement":[{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+		},
+		"duplicate Sid": {
+			sources: []string{
+				`{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			},
+			wantErr: `merging source document 1: duplicate Sid \(A\)`,
+		},
+		"invalid JSON": {
+			sources: []string{`{`},
+			wantErr: `merging source document 0: `,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := &Document{}
+			err := doc.MergeSourceDocuments(testCase.sources)
+
+			if testCase.wantErr != "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !regexache.MustCompile(testCase.wantErr).MatchString(err.Error()) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := json.Marshal(doc)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDocumentMergeOverrideDocuments(t *testing.T) {
+	t.Parallel()
+
+	doc := &Document{}
+	err := doc.MergeSourceDocuments([]string{
+		`{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = doc.MergeOverrideDocuments([]string{
+		`{"Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+		"",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := `{"Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`; string(got) != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
@@ -34,14 +34,16 @@ type dataSourceInterceptor interface {
 
 type dataSourceInterceptors []dataSourceInterceptor
 
-type resourceCRUDRequest interface {
-	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest
+type resourceRequest interface {
+	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest |
+		resource.ModifyPlanRequest | resource.ImportStateRequest | resource.UpgradeStateRequest
 }
-type resourceCRUDResponse interface {
-	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse
+type resourceResponse interface {
+	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse |
+		resource.ModifyPlanResponse | resource.ImportStateResponse | resource.UpgradeStateResponse
 }
 
-// A resource interceptor is functionality invoked during the resource's CRUD request lifecycle.
+// A resource interceptor is functionality invoked during the resource's request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
 // In other cases all interceptors in the chain are run.
@@ -54,11 +56,17 @@ type resourceInterceptor interface {
 	update(context.Context, resource.UpdateRequest, *resource.UpdateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 	// delete is invoke for a Delete call.
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// importState is invoked for an ImportState call.
+	importState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// upgradeState is invoked for a StateUpgrader call.
+	upgradeState(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
 type resourceInterceptors []resourceInterceptor
 
-type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+type resourceInterceptorFunc[Request resourceRequest, Response resourceResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // create returns a slice of interceptors that run on resource Create.
 func (s resourceInterceptors) create() []resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
@@ -88,6 +96,27 @@ func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.Delete
 	})
 }
 
+// modifyPlan returns a slice of interceptors that run on resource ModifyPlan.
+func (s resourceInterceptors) modifyPlan() []resourceInterceptorFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse] {
+		return e.modifyPlan
+	})
+}
+
+// importState returns a slice of interceptors that run on resource ImportState.
+func (s resourceInterceptors) importState() []resourceInterceptorFunc[resource.ImportStateRequest, resource.ImportStateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.ImportStateRequest, resource.ImportStateResponse] {
+		return e.importState
+	})
+}
+
+// upgradeState returns a slice of interceptors that run on resource state upgrade.
+func (s resourceInterceptors) upgradeState() []resourceInterceptorFunc[resource.UpgradeStateRequest, resource.UpgradeStateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.UpgradeStateRequest, resource.UpgradeStateResponse] {
+		return e.upgradeState
+	})
+}
+
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
 type when uint16
@@ -99,8 +128,8 @@ const (
 	Finally                  // Interceptor is invoked after After or OnError
 )
 
-// interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
+// interceptedHandler returns a handler that invokes the specified handler, running any interceptors.
+func interceptedHandler[Request resourceRequest, Response resourceResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
 		// Before interceptors are run first to last.
@@ -118,7 +147,7 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, request, response)
+		diags.Append(f(ctx, request, response)...)
 
 		if diags.HasError() {
 			when = OnError
@@ -269,8 +298,13 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+		f := func(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) diag.Diagnostics {
+			v.ImportState(ctx, request, response)
+			return response.Diagnostics
+		}
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		diags := interceptedHandler(w.interceptors.importState(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
 
 		return
 	}
@@ -281,13 +315,17 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 	)
 }
 
+// ModifyPlan runs any ModifyPlan interceptors, even if the inner resource does not implement ModifyPlan.
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
-
-		return
+	f := func(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) diag.Diagnostics {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			v.ModifyPlan(ctx, request, response)
+		}
+		return response.Diagnostics
 	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := interceptedHandler(w.interceptors.modifyPlan(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -309,13 +347,34 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		upgraders := v.UpgradeState(ctx)
+
+		for k, v := range upgraders {
+			if upgrader := v.StateUpgrader; upgrader != nil {
+				v.StateUpgrader = w.upgradeState(upgrader)
+				upgraders[k] = v
+			}
+		}
 
-		return v.UpgradeState(ctx)
+		return upgraders
 	}
 
 	return nil
 }
 
+// upgradeState returns a state upgrade function that runs any state upgrade interceptors.
+func (w *wrappedResource) upgradeState(upgrader func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse)) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+		f := func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) diag.Diagnostics {
+			upgrader(ctx, request, response)
+			return response.Diagnostics
+		}
+		ctx = w.bootstrapContext(ctx, w.meta)
+		diags := interceptedHandler(w.interceptors.upgradeState(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+	}
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -345,6 +404,13 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 			return ctx, diags
 		}
 
+		// Enforce any tag policy now that all tag values are known.
+		diags = tagsPolicy(ctx, planTags, meta, diags)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
 		// Merge the resource's configured tags with any provider configured default_tags.
 		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
 		// Remove system tags.
@@ -515,6 +581,13 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 			return ctx, diags
 		}
 
+		// Enforce any tag policy now that all tag values are known.
+		diags = tagsPolicy(ctx, planTags, meta, diags)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
 		// Merge the resource's configured tags with any provider configured default_tags.
 		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
 		// Remove system tags.
@@ -589,3 +662,97 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Resource is being destroyed.
+		if request.Plan.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		var planTags fwtypes.Map
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		// Enforce any tag policy at plan time.
+		diags = tagsPolicy(ctx, planTags, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r tagsResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r tagsResourceInterceptor) upgradeState(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// tagsPolicy evaluates any provider configured tag policy against the resource's planned tags merged with any provider configured default_tags.
+// Tag values that are not yet known are not checked.
+func tagsPolicy(ctx context.Context, planTags fwtypes.Map, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	if meta == nil || meta.TagPolicyConfig == nil || planTags.IsUnknown() {
+		return diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	resourceName := inContext.ResourceName
+	if resourceName == "" {
+		resourceName = "<thing>"
+	}
+
+	configTags := make(map[string]*string)
+	for k, v := range planTags.Elements() {
+		v, ok := v.(fwtypes.String)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case v.IsUnknown():
+			configTags[k] = nil
+		case !v.IsNull():
+			configTags[k] = v.ValueStringPointer()
+		}
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, configTags))
+	// Remove system tags.
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	for _, v := range meta.TagPolicyConfig.Violations(tags) {
+		detail := fmt.Sprintf("%s %s: %s", serviceName, resourceName, v)
+
+		if meta.TagPolicyConfig.IsWarning() {
+			diags.AddAttributeWarning(path.Root(names.AttrTags), "Tag policy violation", detail)
+		} else {
+			diags.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", detail)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestTagsResourceInterceptorModifyPlanTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		severity         string
+		expectedSeverity []diag.Severity
+		expectCalled     bool
+	}{
+		"error": {
+			severity:         tftags.PolicySeverityError,
+			expectedSeverity: []diag.Severity{diag.SeverityError},
+		},
+		"warning": {
+			severity:         tftags.PolicySeverityWarning,
+			expectedSeverity: []diag.Severity{diag.SeverityWarning},
+			expectCalled:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			meta := &conns.AWSClient{
+				TagPolicyConfig: &tftags.PolicyConfig{
+					RequiredKeys: []string{"Owner"},
+					Severity:     testCase.severity,
+				},
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, nil, nil)
+
+			interceptors := resourceInterceptors{
+				tagsResourceInterceptor{
+					tags: &types.ServicePackageResourceTags{},
+				},
+			}
+
+			var called bool
+			f := interceptedHandler(interceptors.modifyPlan(), func(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse) diag.Diagnostics {
+				called = true
+				return nil
+			}, meta)
+
+			diags := f(ctx, resource.ModifyPlanRequest{Plan: testTagsPlan(map[string]string{"Name": "test"})}, &resource.ModifyPlanResponse{})
+
+			if got, want := called, testCase.expectCalled; got != want {
+				t.Errorf("called = %t, want %t", got, want)
+			}
+
+			if got, want := len(diags), len(testCase.expectedSeverity); got != want {
+				t.Fatalf("length of diags = %d, want %d", got, want)
+			}
+			for i, v := range diags {
+				if got, want := v.Severity(), testCase.expectedSeverity[i]; got != want {
+					t.Errorf("diags[%d].Severity = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func testTagsPlan(tags map[string]string) tfsdk.Plan {
+	values := make(map[string]tftypes.Value, len(tags))
+	for k, v := range tags {
+		values[k] = tftypes.NewValue(tftypes.String, v)
+	}
+
+	return tfsdk.Plan{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"tags": schema.MapAttribute{
+					ElementType: fwtypes.StringType,
+					Optional:    true,
+				},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"tags": tftypes.Map{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values),
+		}),
+	}
+}
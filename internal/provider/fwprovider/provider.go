@@ -167,9 +167,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "Roles to assume, in order. Each role is assumed using the credentials of the previous role.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -288,6 +286,104 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate of API requests made to individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of API requests that may be made at once. Defaults to 1.",
+						},
+						"operations": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The API operations to which the rate limit applies, e.g. `ChangeResourceRecordSets`. If not set, the rate limit applies to all of the service's API operations.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The sustained rate at which API requests may be made.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to which the rate limit applies, e.g. `route53`. Any key supported in the `endpoints` block may be used.",
+						},
+					},
+				},
+			},
+			"retry_policy": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to override retry behavior for individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum delay between retries of a service's API requests. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times a service's API request is being executed.",
+						},
+						"min_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The base delay between retries of a service's API requests. The delay doubles with each retry. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional API error codes for which a service's API requests are retried.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to which the retry policy applies, e.g. `route53`. Any key supported in the `endpoints` block may be used.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to enforce a tag policy across all taggable resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"organizations_policy": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to also enforce the effective AWS Organizations tag policy for the provider's account.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that all taggable resources must have.",
+						},
+						"severity": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether tag policy violations are reported as errors or warnings. Valid values are `error` and `warning`. Defaults to `error`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with constraints on the values of individual resource tag keys.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Values allowed for the resource tag.",
+									},
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key to which the constraints apply.",
+									},
+									"value_patterns": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Regular expressions, one of which the resource tag's value must match if it is not one of the allowed values.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -466,6 +562,14 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRContainsFunction,
+		tffunction.NewCIDRNextFreeFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRSplitFunction,
+		tffunction.NewCloudWatchEventPatternMatchesFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
 	}
 }
 
@@ -66,17 +66,20 @@ const (
 	Finally                  // Interceptor is invoked after After or OnError
 )
 
-// why represents the CRUD operation(s) that an interceptor is run.
+// why represents the operation(s) that an interceptor is run.
 // Multiple values can be ORed together.
 type why uint16
 
 const (
-	Create why = 1 << iota // Interceptor is invoked for a Create call
-	Read                   // Interceptor is invoked for a Read call
-	Update                 // Interceptor is invoked for an Update call
-	Delete                 // Interceptor is invoked for a Delete call
-
-	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
+	Create        why = 1 << iota // Interceptor is invoked for a Create call
+	Read                          // Interceptor is invoked for a Read call
+	Update                        // Interceptor is invoked for an Update call
+	Delete                        // Interceptor is invoked for a Delete call
+	CustomizeDiff                 // Interceptor is invoked for a CustomizeDiff call
+	Import                        // Interceptor is invoked for an Importer StateContext call
+	StateUpgrade                  // Interceptor is invoked for a StateUpgrader Upgrade call
+
+	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all CRUD calls
 )
 
 type interceptorItems []interceptorItem
@@ -91,47 +94,111 @@ func (s interceptorItems) why(why why) interceptorItems {
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
 func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
-		// Before interceptors are run first to last.
-		forward := interceptors.why(why)
 
-		when := Before
-		for _, v := range forward {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+		return interceptedCall(ctx, interceptors, d, meta, why, func(ctx context.Context) diag.Diagnostics {
+			return f(ctx, d, meta)
+		})
+	}
+}
+
+// interceptedCall invokes the specified function, running any interceptors for the specified operation.
+func interceptedCall(ctx context.Context, interceptors interceptorItems, d schemaResourceData, meta any, why why, f func(context.Context) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// Before interceptors are run first to last.
+	forward := interceptors.why(why)
 
-				// Short circuit if any Before interceptor errors.
-				if diags.HasError() {
-					return diags
-				}
+	when := Before
+	for _, v := range forward {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+
+			// Short circuit if any Before interceptor errors.
+			if diags.HasError() {
+				return diags
 			}
 		}
+	}
 
-		// All other interceptors are run last to first.
-		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
+	// All other interceptors are run last to first.
+	reverse := slices.Reverse(forward)
+	diags = append(diags, f(ctx)...)
 
-		if diags.HasError() {
-			when = OnError
-		} else {
-			when = After
-		}
-		for _, v := range reverse {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
-			}
+	if diags.HasError() {
+		when = OnError
+	} else {
+		when = After
+	}
+	for _, v := range reverse {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 		}
+	}
 
-		when = Finally
-		for _, v := range reverse {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
-			}
+	when = Finally
+	for _, v := range reverse {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 		}
+	}
+
+	return diags
+}
+
+// resourceDiffData adapts a Plugin SDK v2 resource diff for use by CustomizeDiff interceptors.
+// Set sets the planned value of a computed attribute.
+type resourceDiffData struct {
+	*schema.ResourceDiff
+}
+
+func (d resourceDiffData) Set(key string, value any) error {
+	return d.SetNew(key, value)
+}
+
+// rawStateData adapts a resource's raw state for use by StateUpgrade interceptors.
+// Only top-level attributes are accessible.
+// Before interceptors see the state being upgraded and all other interceptors see the upgraded state.
+type rawStateData struct {
+	state map[string]any
+}
 
-		return diags
+func (d *rawStateData) Get(key string) any {
+	return d.state[key]
+}
+
+func (d *rawStateData) GetChange(key string) (any, any) {
+	return d.state[key], d.state[key]
+}
+
+func (d *rawStateData) GetRawConfig() cty.Value {
+	return cty.NilVal
+}
+
+func (d *rawStateData) GetRawPlan() cty.Value {
+	return cty.NilVal
+}
+
+func (d *rawStateData) GetRawState() cty.Value {
+	return cty.NilVal
+}
+
+func (d *rawStateData) HasChange(key string) bool {
+	return false
+}
+
+func (d *rawStateData) Id() string {
+	v, _ := d.state["id"].(string)
+
+	return v
+}
+
+func (d *rawStateData) Set(key string, value any) error {
+	if d.state == nil {
+		d.state = make(map[string]any)
 	}
+	d.state[key] = value
+
+	return nil
 }
 
 // contextFunc augments Context.
@@ -175,15 +242,33 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, d, meta)
+		var results []*schema.ResourceData
+		diags := interceptedCall(ctx, r.interceptors, d, meta, Import, func(ctx context.Context) diag.Diagnostics {
+			var err error
+			results, err = f(ctx, d, meta)
+
+			return sdkdiag.AppendFromErr(nil, err)
+		})
+
+		return results, sdkdiag.DiagnosticsError(diags)
 	}
 }
 
+// CustomizeDiff returns a CustomizeDiffFunc that runs any CustomizeDiff interceptors.
+// The wrapped function may be nil, in which case only the interceptors are run.
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, d, meta)
+		diags := interceptedCall(ctx, r.interceptors, resourceDiffData{d}, meta, CustomizeDiff, func(ctx context.Context) diag.Diagnostics {
+			if f == nil {
+				return nil
+			}
+
+			return sdkdiag.AppendFromErr(nil, f(ctx, d, meta))
+		})
+
+		return sdkdiag.DiagnosticsError(diags)
 	}
 }
 
@@ -191,7 +276,15 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, rawState, meta)
+		d := &rawStateData{state: rawState}
+		diags := interceptedCall(ctx, r.interceptors, d, meta, StateUpgrade, func(ctx context.Context) diag.Diagnostics {
+			var err error
+			d.state, err = f(ctx, d.state, meta)
+
+			return sdkdiag.AppendFromErr(nil, err)
+		})
+
+		return d.state, sdkdiag.DiagnosticsError(diags)
 	}
 }
 
@@ -237,7 +330,16 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 	switch when {
 	case Before:
 		switch why {
+		case CustomizeDiff:
+			// Enforce any tag policy at plan time.
+			diags = tagsPolicyFunc(ctx, d, serviceName, resourceName, meta, why, diags)
 		case Create, Update:
+			// Enforce any tag policy now that all tag values are known.
+			diags = tagsPolicyFunc(ctx, d, serviceName, resourceName, meta, why, diags)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
 			// Merge the resource's configured tags with any provider configured default_tags.
 			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
 			// Remove system tags.
@@ -5,11 +5,20 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestInterceptorsWhy(t *testing.T) {
@@ -51,6 +60,15 @@ func TestInterceptorsWhy(t *testing.T) {
 	if got, want := len(interceptors.why(Delete)), 1; got != want {
 		t.Errorf("length of interceptors.Why(Delete) = %v, want %v", got, want)
 	}
+	if got, want := len(interceptors.why(CustomizeDiff)), 0; got != want {
+		t.Errorf("length of interceptors.Why(CustomizeDiff) = %v, want %v", got, want)
+	}
+	if got, want := len(interceptors.why(Import)), 0; got != want {
+		t.Errorf("length of interceptors.Why(Import) = %v, want %v", got, want)
+	}
+	if got, want := len(interceptors.why(StateUpgrade)), 0; got != want {
+		t.Errorf("length of interceptors.Why(StateUpgrade) = %v, want %v", got, want)
+	}
 }
 
 func TestInterceptedHandler(t *testing.T) {
@@ -93,3 +111,280 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestWrappedResourceState(t *testing.T) {
+	t.Parallel()
+
+	var interceptors interceptorItems
+
+	// Normalize the import ID.
+	interceptors = append(interceptors, interceptorItem{
+		when: Before,
+		why:  Import,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			if v, ok := d.(interface{ SetId(string) }); ok {
+				v.SetId(strings.ToLower(d.Id()))
+			}
+			return ctx, diags
+		}),
+	})
+	interceptors = append(interceptors, interceptorItem{
+		when: Before,
+		why:  AllOps,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			return ctx, sdkdiag.AppendErrorf(diags, "unexpected CRUD interceptor call")
+		}),
+	})
+
+	rs := &wrappedResource{
+		bootstrapContext: func(ctx context.Context, meta any) context.Context {
+			return ctx
+		},
+		interceptors: interceptors,
+	}
+
+	var importID string
+	f := rs.State(func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		importID = d.Id()
+		return []*schema.ResourceData{d}, nil
+	})
+
+	d := (&schema.Resource{}).Data(nil)
+	d.SetId("ID-1")
+
+	got, err := f(context.Background(), d, 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := importID, "id-1"; got != want {
+		t.Errorf("import ID = %v, want %v", got, want)
+	}
+	if got, want := len(got), 1; got != want {
+		t.Errorf("length of results = %v, want %v", got, want)
+	}
+}
+
+func TestWrappedResourceCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	var interceptors interceptorItems
+
+	interceptors = append(interceptors, interceptorItem{
+		when: Before,
+		why:  CustomizeDiff,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			return ctx, sdkdiag.AppendErrorf(diags, "policy violation")
+		}),
+	})
+
+	rs := &wrappedResource{
+		bootstrapContext: func(ctx context.Context, meta any) context.Context {
+			return ctx
+		},
+		interceptors: interceptors,
+	}
+
+	var called bool
+	f := rs.CustomizeDiff(func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		called = true
+		return nil
+	})
+
+	err := f(context.Background(), nil, 42)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := err.Error(), "policy violation"; got != want {
+		t.Errorf("error = %v, want %v", got, want)
+	}
+	if called {
+		t.Error("CustomizeDiff called after Before interceptor error")
+	}
+
+	// The resource need not implement CustomizeDiff.
+	if err := rs.CustomizeDiff(nil)(context.Background(), nil, 42); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestWrappedResourceStateUpgrade(t *testing.T) {
+	t.Parallel()
+
+	var (
+		interceptors interceptorItems
+		seen         []string
+	)
+
+	interceptors = append(interceptors, interceptorItem{
+		when: Before | After | OnError,
+		why:  StateUpgrade,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			switch when {
+			case Before:
+				seen = append(seen, "before:"+d.Get("name").(string))
+			case After:
+				seen = append(seen, "after:"+d.Get("name").(string))
+				if err := d.Set("audited", true); err != nil {
+					return ctx, sdkdiag.AppendFromErr(diags, err)
+				}
+			case OnError:
+				seen = append(seen, "error")
+			}
+			return ctx, diags
+		}),
+	})
+
+	rs := &wrappedResource{
+		bootstrapContext: func(ctx context.Context, meta any) context.Context {
+			return ctx
+		},
+		interceptors: interceptors,
+	}
+
+	f := rs.StateUpgrade(func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
+		return map[string]interface{}{
+			"id":   rawState["id"],
+			"name": strings.ToUpper(rawState["name"].(string)),
+		}, nil
+	})
+
+	got, err := f(context.Background(), map[string]interface{}{"id": "id-1", "name": "test"}, 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := strings.Join(seen, ","), "before:test,after:TEST"; got != want {
+		t.Errorf("interceptor calls = %v, want %v", got, want)
+	}
+	if got, want := got["audited"], true; got != want {
+		t.Errorf("audited = %v, want %v", got, want)
+	}
+
+	seen = nil
+	f = rs.StateUpgrade(func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
+		return nil, errors.New("upgrade error")
+	})
+
+	if _, err := f(context.Background(), map[string]interface{}{"id": "id-1", "name": "test"}, 42); err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := strings.Join(seen, ","), "before:test,error"; got != want {
+		t.Errorf("interceptor calls = %v, want %v", got, want)
+	}
+}
+
+func TestInterceptedHandler_rateLimitFairness(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n     = 25 // Concurrent requests per resource type.
+		burst = 2 * n
+	)
+
+	ctx := context.Background()
+
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	// The clock is stopped, so the bucket is never refilled and every request must be granted its own token.
+	clock := func() time.Time {
+		return time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	config := &conns.Config{
+		AccessKey: servicemocks.MockStaticAccessKey,
+		Endpoints: map[string]string{
+			names.STS: ts.URL,
+		},
+		MaxRetries: 1,
+		RateLimiterOptions: []ratelimit.OptionsFunc{
+			ratelimit.WithClock(clock),
+		},
+		RateLimits: map[string][]conns.RateLimit{
+			names.STS: {
+				{
+					Burst:             burst,
+					RequestsPerSecond: 1,
+				},
+			},
+		},
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SecretKey:               servicemocks.MockStaticSecretKey,
+		SkipCredsValidation:     true,
+		SkipRequestingAccountId: true,
+	}
+
+	p, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, diags := config.ConfigureProvider(ctx, p.Meta().(*conns.AWSClient))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	// Each resource type's handler makes an API call through the service's rate limiting middleware.
+	var (
+		mu     sync.Mutex
+		counts = make(map[string]int)
+	)
+	handler := func(resourceType string) schema.CreateContextFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			if _, err := meta.(*conns.AWSClient).STSClient(ctx).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "%s: %s", resourceType, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			counts[resourceType]++
+
+			return diags
+		}
+	}
+
+	resourceTypes := []string{"aws_route53_record", "aws_iam_role_policy_attachment"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		for _, resourceType := range resourceTypes {
+			wg.Add(1)
+			go func(f schema.CreateContextFunc) {
+				defer wg.Done()
+
+				if diags := interceptedHandler(bootstrapContext, nil, f, Create)(ctx, nil, meta); diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+			}(handler(resourceType))
+		}
+	}
+	wg.Wait()
+
+	for _, resourceType := range resourceTypes {
+		if got, want := counts[resourceType], n; got != want {
+			t.Errorf("requests granted for %s = %d, want %d", resourceType, got, want)
+		}
+	}
+
+	// Every concurrent request consumed its own token, so the bucket is now empty and the next request must wait.
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	diags = interceptedHandler(bootstrapContext, nil, handler("aws_route53_record"), Create)(ctx, nil, meta)
+	if !diags.HasError() {
+		t.Fatal("expected error")
+	}
+	if got, want := diags[0].Summary, context.DeadlineExceeded.Error(); !strings.Contains(got, want) {
+		t.Errorf("error = %q, want %q", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -152,6 +153,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": rateLimitSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -164,6 +166,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"retry_policy": retryPolicySchema(),
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -228,6 +231,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": tagPolicySchema(),
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -401,7 +405,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				interceptors = append(interceptors, interceptorItem{
 					when: Before | After | Finally,
-					why:  Create | Read | Update,
+					why:  Create | Read | Update | CustomizeDiff,
 					interceptor: tagsResourceInterceptor{
 						tags:       v.Tags,
 						updateFunc: tagsUpdateFunc,
@@ -432,12 +436,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
-			if v := r.CustomizeDiff; v != nil {
+			// CustomizeDiff interceptors run even if the resource does not implement CustomizeDiff.
+			if v := r.CustomizeDiff; v != nil || len(interceptors.why(CustomizeDiff)) > 0 {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
-			for _, stateUpgrader := range r.StateUpgraders {
+			for i, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
-					stateUpgrader.Upgrade = rs.StateUpgrade(v)
+					r.StateUpgraders[i].Upgrade = rs.StateUpgrade(v)
 				}
 			}
 
@@ -506,6 +511,24 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.RetryMode = mode
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, dx := expandRateLimits(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("retry_policy"); ok && len(v.([]interface{})) > 0 {
+		retryPolicies, dx := expandRetryPolicies(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RetryPolicies = retryPolicies
+	}
+
 	if v, ok := d.Get("s3_us_east_1_regional_endpoint").(string); ok && v != "" {
 		config.S3USEast1RegionalEndpoint = conns.NormalizeS3USEast1RegionalEndpoint(v)
 	}
@@ -514,14 +537,23 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			config.AssumeRole = append(config.AssumeRole, *assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -571,6 +603,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicyConfig, dx := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -605,9 +646,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Roles to assume, in order. Each role is assumed using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -731,6 +772,143 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to enforce a tag policy across all taggable resources.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"organizations_policy": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to also enforce the effective AWS Organizations tag policy for the provider's account.",
+				},
+				"required_keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Resource tag keys that all taggable resources must have.",
+				},
+				"rule": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with constraints on the values of individual resource tag keys.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_values": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Values allowed for the resource tag.",
+							},
+							"key": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Resource tag key to which the constraints apply.",
+							},
+							"value_patterns": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringIsValidRegExp,
+								},
+								Description: "Regular expressions, one of which the resource tag's value must match if it is not one of the allowed values.",
+							},
+						},
+					},
+				},
+				"severity": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Whether tag policy violations are reported as errors or warnings. Valid values are `error` and `warning`. Defaults to `error`.",
+					ValidateFunc: validation.StringInSlice([]string{tftags.PolicySeverityError, tftags.PolicySeverityWarning}, false),
+				},
+			},
+		},
+	}
+}
+
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the rate of API requests made to individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of API requests that may be made at once. Defaults to 1.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"operations": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The API operations to which the rate limit applies, e.g. `ChangeResourceRecordSets`. If not set, the rate limit applies to all of the service's API operations.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					Description:  "The sustained rate at which API requests may be made.",
+					ValidateFunc: validation.FloatAtLeast(0.001),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service to which the rate limit applies, e.g. `route53`. Any key supported in the `endpoints` block may be used.",
+					ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+				},
+			},
+		},
+	}
+}
+
+func retryPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to override retry behavior for individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum delay between retries of a service's API requests. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of times a service's API request is being executed.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"min_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The base delay between retries of a service's API requests. The delay doubles with each retry. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Additional API error codes for which a service's API requests are retried.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service to which the retry policy applies, e.g. `route53`. Any key supported in the `endpoints` block may be used.",
+					ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -852,6 +1030,200 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandRateLimits(_ context.Context, tfList []interface{}) (map[string][]conns.RateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rateLimitsPath := cty.GetAttrPath("rate_limit")
+	rateLimits := make(map[string][]conns.RateLimit)
+	serviceWide := make(map[string]bool)
+	operations := make(map[string]map[string]bool)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		elementPath := rateLimitsPath.IndexInt(i)
+
+		pkg, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value", err.Error()))
+			continue
+		}
+
+		rateLimit := conns.RateLimit{
+			Burst: 1,
+		}
+
+		if v, ok := tfMap["burst"].(int); ok && v > 0 {
+			rateLimit.Burst = v
+		}
+
+		if v, ok := tfMap["operations"].(*schema.Set); ok && v.Len() > 0 {
+			rateLimit.Operations = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		if len(rateLimit.Operations) == 0 {
+			if serviceWide[pkg] {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					elementPath.GetAttr("service"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("Duplicate rate limit for all API operations of service %q.", pkg),
+				))
+				continue
+			}
+			serviceWide[pkg] = true
+		} else {
+			if operations[pkg] == nil {
+				operations[pkg] = make(map[string]bool)
+			}
+
+			var duplicate bool
+			for _, operation := range rateLimit.Operations {
+				if operations[pkg][operation] {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(
+						elementPath.GetAttr("operations"),
+						"Invalid Attribute Value",
+						fmt.Sprintf("Duplicate rate limit for API operation %q of service %q.", operation, pkg),
+					))
+					duplicate = true
+				}
+				operations[pkg][operation] = true
+			}
+			if duplicate {
+				continue
+			}
+		}
+
+		rateLimits[pkg] = append(rateLimits[pkg], rateLimit)
+	}
+
+	return rateLimits, diags
+}
+
+func expandRetryPolicies(_ context.Context, tfList []interface{}) (map[string]conns.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	retryPoliciesPath := cty.GetAttrPath("retry_policy")
+	retryPolicies := make(map[string]conns.RetryPolicy)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		elementPath := retryPoliciesPath.IndexInt(i)
+
+		pkg, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value", err.Error()))
+			continue
+		}
+
+		if _, ok := retryPolicies[pkg]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate retry policy for service %q.", pkg),
+			))
+			continue
+		}
+
+		retryPolicy := conns.RetryPolicy{}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			retryPolicy.MaxBackoff = duration
+		}
+
+		if v, ok := tfMap["max_retries"].(int); ok && v > 0 {
+			retryPolicy.MaxRetries = v
+		}
+
+		if v, ok := tfMap["min_backoff"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			retryPolicy.MinBackoff = duration
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			retryPolicy.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		retryPolicies[pkg] = retryPolicy
+	}
+
+	return retryPolicies, diags
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfMap == nil {
+		return nil, diags
+	}
+
+	rulesPath := cty.GetAttrPath("tag_policy").IndexInt(0).GetAttr("rule")
+	policyConfig := &tftags.PolicyConfig{
+		Severity: tftags.PolicySeverityError,
+	}
+
+	if v, ok := tfMap["organizations_policy"].(bool); ok {
+		policyConfig.OrganizationsPolicy = v
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for i, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key: tfMap["key"].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["value_patterns"].(*schema.Set); ok && v.Len() > 0 {
+				for _, v := range flex.ExpandStringValueSet(v) {
+					// Patterns are validated in the schema, but not if they were unknown during validation.
+					re, err := regexp.Compile(v)
+					if err != nil {
+						diags = append(diags, errs.NewAttributeErrorDiagnostic(
+							rulesPath.IndexInt(i).GetAttr("value_patterns"),
+							"Invalid Attribute Value",
+							fmt.Sprintf("%q: %s", v, err),
+						))
+						continue
+					}
+
+					rule.ValuePatterns = append(rule.ValuePatterns, re)
+				}
+			}
+
+			policyConfig.Rules = append(policyConfig.Rules, rule)
+		}
+	}
+
+	if v, ok := tfMap["severity"].(string); ok && v != "" {
+		policyConfig.Severity = v
+	}
+
+	return policyConfig, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -12,6 +12,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -235,3 +237,60 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		valuePatterns []interface{}
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			valuePatterns: []interface{}{`^cc-\d+$`},
+		},
+		"invalid": {
+			valuePatterns: []interface{}{`^cc-(\d+$`},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("tag_policy").IndexInt(0).GetAttr("rule").IndexInt(0).GetAttr("value_patterns"),
+					"Invalid Attribute Value",
+					"\"^cc-(\\\\d+$\": error parsing regexp: missing closing ): `^cc-(\\d+$`",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policyConfig, diags := expandTagPolicy(ctx, map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"key":            "CostCenter",
+						"value_patterns": schema.NewSet(schema.HashString, testCase.valuePatterns),
+					},
+				},
+			})
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if got, want := len(policyConfig.Rules), 1; got != want {
+				t.Fatalf("length of Rules = %d, want %d", got, want)
+			}
+			if got, want := len(policyConfig.Rules[0].ValuePatterns), 1; got != want {
+				t.Errorf("length of ValuePatterns = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -5,8 +5,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -172,3 +175,74 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagsPolicyFunc evaluates any provider configured tag policy against the resource's configured tags merged with any provider configured default_tags.
+// Tag values that are not yet known are not checked.
+// Violations reported as warnings are returned as warning diagnostics on Create and Update.
+// CustomizeDiff cannot return warnings so at plan time they are only logged.
+func tagsPolicyFunc(ctx context.Context, d schemaResourceData, serviceName, resourceName string, meta any, why why, diags diag.Diagnostics) diag.Diagnostics {
+	policyConfig := meta.(*conns.AWSClient).TagPolicyConfig
+	if policyConfig == nil {
+		return diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return diags
+	}
+
+	c := config.GetAttr(names.AttrTags)
+	if !c.IsKnown() {
+		return diags
+	}
+
+	configTags := make(map[string]*string)
+	if !c.IsNull() {
+		for k, v := range c.AsValueMap() {
+			switch {
+			case !v.IsKnown():
+				configTags[k] = nil
+			case !v.IsNull():
+				configTags[k] = aws.String(v.AsString())
+			}
+		}
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, configTags))
+	// Remove system tags.
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	severity := diag.Error
+	if policyConfig.IsWarning() {
+		severity = diag.Warning
+	}
+
+	for _, v := range policyConfig.Violations(tags) {
+		if severity == diag.Warning && why == CustomizeDiff {
+			tflog.Warn(ctx, "Tag policy violation", map[string]any{
+				"tf_aws.tag_policy.violation": v,
+			})
+			continue
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      severity,
+			Summary:       "Tag policy violation",
+			Detail:        fmt.Sprintf("%s %s: %s", serviceName, resourceName, v),
+			AttributePath: cty.GetAttrPath(names.AttrTags),
+		})
+	}
+
+	return diags
+}
@@ -148,3 +148,104 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+func TestTagsResourceInterceptorTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		severity         string
+		why              why
+		expectedSeverity []diag.Severity
+		expectCalled     bool
+	}{
+		"Create error": {
+			severity:         tftags.PolicySeverityError,
+			why:              Create,
+			expectedSeverity: []diag.Severity{diag.Error},
+		},
+		"Create warning": {
+			severity:         tftags.PolicySeverityWarning,
+			why:              Create,
+			expectedSeverity: []diag.Severity{diag.Warning},
+			expectCalled:     true,
+		},
+		"CustomizeDiff error": {
+			severity:         tftags.PolicySeverityError,
+			why:              CustomizeDiff,
+			expectedSeverity: []diag.Severity{diag.Error},
+		},
+		"CustomizeDiff warning": {
+			severity:     tftags.PolicySeverityWarning,
+			why:          CustomizeDiff,
+			expectCalled: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptors := interceptorItems{
+				{
+					when: Before,
+					why:  Create | CustomizeDiff,
+					interceptor: tagsResourceInterceptor{
+						tags:       &types.ServicePackageResourceTags{},
+						updateFunc: tagsUpdateFunc,
+						readFunc:   tagsReadFunc,
+					},
+				},
+			}
+
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": &mockService{},
+				},
+				DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{}),
+				IgnoreTagsConfig:  expandIgnoreTags(context.Background(), map[string]interface{}{}),
+				TagPolicyConfig: &tftags.PolicyConfig{
+					RequiredKeys: []string{"Owner"},
+					Severity:     testCase.severity,
+				},
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig, conn.IgnoreTagsConfig)
+
+			var called bool
+			diags := interceptedCall(ctx, interceptors, &taggedResourceData{}, conn, testCase.why, func(context.Context) diag.Diagnostics {
+				called = true
+				return nil
+			})
+
+			if got, want := called, testCase.expectCalled; got != want {
+				t.Errorf("called = %t, want %t", got, want)
+			}
+
+			if got, want := len(diags), len(testCase.expectedSeverity); got != want {
+				t.Fatalf("length of diags = %d, want %d", got, want)
+			}
+			for i, v := range diags {
+				if got, want := v.Severity, testCase.expectedSeverity[i]; got != want {
+					t.Errorf("diags[%d].Severity = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+type taggedResourceData struct {
+	resourceData
+}
+
+func (d *taggedResourceData) Get(key string) any {
+	if key == "tags" {
+		return map[string]interface{}{
+			"tag1": "value1",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket rate limiter.
+// The bucket holds at most `burst` tokens and is refilled at `rate` tokens per second.
+// Each request consumes a single token.
+//
+// Requests are granted tokens in the order in which they arrive: a request that cannot be
+// satisfied immediately reserves the next available token and waits until it is due.
+// No request is starved by requests that arrive after it.
+type Limiter struct {
+	burst float64
+	rate  float64 // Tokens per second.
+
+	mu     sync.Mutex
+	last   time.Time // Time at which `tokens` was last computed.
+	tokens float64   // May be negative, indicating outstanding reservations.
+
+	now func() time.Time
+}
+
+// OptionsFunc configures a Limiter.
+type OptionsFunc func(*Limiter)
+
+// WithClock sets the function that a Limiter uses to get the current time.
+// The default is time.Now.
+func WithClock(now func() time.Time) OptionsFunc {
+	return func(l *Limiter) {
+		l.now = now
+	}
+}
+
+// New returns a new Limiter that allows requests at up to `rate` requests per second
+// with bursts of at most `burst` requests.
+// A `burst` of less than 1 is treated as 1.
+func New(rate float64, burst int, optFns ...OptionsFunc) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	l := &Limiter{
+		burst:  float64(burst),
+		rate:   rate,
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+
+	for _, fn := range optFns {
+		fn(l)
+	}
+
+	return l
+}
+
+// Reserve reserves a token and returns the duration that the caller must wait before using it.
+func (l *Limiter) Reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance()
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	// The reservation is satisfied once the bucket has been refilled to zero.
+	return durationFromTokens(-l.tokens, l.rate)
+}
+
+// Wait blocks until a token is available or the Context is done.
+// If the Context is done before the token is available, the reservation is returned to the bucket
+// and the Context's error is returned.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := l.Reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// cancel returns an unused reservation to the bucket.
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance()
+	l.tokens = min(l.tokens+1, l.burst)
+}
+
+// advance refills the bucket for the time elapsed since it was last computed.
+// The caller must hold the lock.
+func (l *Limiter) advance() {
+	now := l.now()
+
+	if !l.last.IsZero() {
+		if elapsed := now.Sub(l.last); elapsed > 0 {
+			l.tokens = min(l.tokens+elapsed.Seconds()*l.rate, l.burst)
+		}
+	}
+	l.last = now
+}
+
+func durationFromTokens(tokens, rate float64) time.Duration {
+	if rate <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	// Round up so that a reservation is never satisfied early.
+	return time.Duration(math.Ceil(tokens * float64(time.Second) / rate))
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(rate float64, burst int) (*Limiter, *testClock) {
+	clock := &testClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	l := New(rate, burst, WithClock(clock.Now))
+
+	return l, clock
+}
+
+func TestLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	l, clock := newTestLimiter(2, 3)
+
+	// The bucket starts full.
+	for i := 0; i < 3; i++ {
+		if got, want := l.Reserve(), time.Duration(0); got != want {
+			t.Errorf("Reserve() #%d = %s, want %s", i, got, want)
+		}
+	}
+
+	// Subsequent reservations queue behind each other.
+	if got, want := l.Reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("Reserve() = %s, want %s", got, want)
+	}
+	if got, want := l.Reserve(), 1*time.Second; got != want {
+		t.Errorf("Reserve() = %s, want %s", got, want)
+	}
+
+	// Once the outstanding reservations have been satisfied the bucket refills, up to its capacity.
+	clock.Advance(1 * time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if got, want := l.Reserve(), time.Duration(0); got != want {
+			t.Errorf("Reserve() #%d = %s, want %s", i, got, want)
+		}
+	}
+	if got, want := l.Reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("Reserve() = %s, want %s", got, want)
+	}
+}
+
+func TestLimiterReserve_fairness(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n     = 100
+		rate  = 10
+		burst = 5
+	)
+
+	l, _ := newTestLimiter(rate, burst)
+
+	var (
+		mu     sync.Mutex
+		delays []time.Duration
+		wg     sync.WaitGroup
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			delay := l.Reserve()
+
+			mu.Lock()
+			defer mu.Unlock()
+			delays = append(delays, delay)
+		}()
+	}
+
+	wg.Wait()
+
+	// Every concurrent caller must be allocated its own slot and there must be no gaps.
+	sort.Slice(delays, func(i, j int) bool { return delays[i] < delays[j] })
+
+	for i, got := range delays {
+		want := time.Duration(0)
+		if i >= burst {
+			want = time.Duration(i-burst+1) * time.Second / rate
+		}
+
+		if got != want {
+			t.Errorf("delay #%d = %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	l := New(100, 1)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait: %s", err)
+		}
+	}
+
+	if got, want := time.Since(start), 40*time.Millisecond; got < want {
+		t.Errorf("elapsed = %s, want at least %s", got, want)
+	}
+}
+
+func TestLimiterWait_canceled(t *testing.T) {
+	t.Parallel()
+
+	l, _ := newTestLimiter(1, 1)
+	l.Reserve()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Wait: got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The canceled reservation is returned to the bucket.
+	if got, want := l.Reserve(), 1*time.Second; got != want {
+		t.Errorf("Reserve() = %s, want %s", got, want)
+	}
+}
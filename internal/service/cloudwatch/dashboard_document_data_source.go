@@ -0,0 +1,720 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// dashboardGridWidth is the number of columns in the CloudWatch dashboard grid.
+	dashboardGridWidth = 24
+
+	dashboardWidgetDefaultHeight = 6
+	dashboardWidgetDefaultWidth  = 6
+)
+
+var dashboardWidgetTypes = []string{"alarm", "explorer", "log", "metric", "text"}
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func dataSourceDashboardDocument() *schema.Resource {
+	metricQuerySchema := metricDataQuerySchema()
+	// Dashboard metric widgets don't support units.
+	delete(metricQuerySchema["metric"].Elem.(*schema.Resource).Schema, "unit")
+	metricQuerySchema["visible"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp", "timestamp"}, false),
+									},
+									"states": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, false),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"explorer": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"legend_position": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"bottom", "hidden", "right"}, false),
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"resource_type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"rows_per_page": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"split_by": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "timeSeries"}, false),
+									},
+									"widgets_per_row": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardWidgetDefaultHeight,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringDoesNotContainAny("'"),
+										},
+									},
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "table", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_query": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 500,
+										Elem: &schema.Resource{
+											Schema: metricQuerySchema,
+										},
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"bar", "gauge", "pie", "singleValue", "table", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardWidgetDefaultWidth,
+							ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	region := meta.(*conns.AWSClient).Region
+
+	tfList := d.Get("widget").([]interface{})
+	widgets := make([]interface{}, 0, len(tfList))
+	x, y, rowHeight := 0, 0, 0
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		widgetType, properties, err := expandDashboardWidgetProperties(tfMap, region)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: widget[%d]: %s", i, err)
+		}
+
+		// Lay the widgets out left to right, starting a new row when a widget doesn't fit in the current one.
+		width, height := tfMap["width"].(int), tfMap["height"].(int)
+
+		if x+width > dashboardGridWidth {
+			x, y, rowHeight = 0, y+rowHeight, 0
+		}
+
+		widgets = append(widgets, map[string]interface{}{
+			"height":     height,
+			"properties": properties,
+			"type":       widgetType,
+			"width":      width,
+			"x":          x,
+			"y":          y,
+		})
+
+		x += width
+		rowHeight = max(rowHeight, height)
+	}
+
+	body := map[string]interface{}{
+		"widgets": widgets,
+	}
+
+	if v, ok := d.GetOk("end"); ok {
+		body["end"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("period_override"); ok {
+		body["periodOverride"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("start"); ok {
+		body["start"] = v.(string)
+	}
+
+	jsonBytes, err := json.Marshal(body)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: %s", err)
+	}
+
+	// Normalize the same way as the aws_cloudwatch_dashboard resource's dashboard_body.
+	jsonString, err := structure.NormalizeJsonString(string(jsonBytes))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: %s", err)
+	}
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+// expandDashboardWidgetProperties returns the type and properties of the single widget type block configured in tfMap.
+func expandDashboardWidgetProperties(tfMap map[string]interface{}, region string) (string, map[string]interface{}, error) {
+	var widgetType string
+	var tfBlock map[string]interface{}
+
+	for _, k := range dashboardWidgetTypes {
+		v, ok := tfMap[k].([]interface{})
+		if !ok || len(v) == 0 {
+			continue
+		}
+
+		if widgetType != "" {
+			return "", nil, fmt.Errorf("only one of `%s` can be specified", strings.Join(dashboardWidgetTypes, "`, `"))
+		}
+
+		widgetType = k
+		tfBlock, _ = v[0].(map[string]interface{})
+	}
+
+	if widgetType == "" {
+		return "", nil, fmt.Errorf("one of `%s` must be specified", strings.Join(dashboardWidgetTypes, "`, `"))
+	}
+
+	if tfBlock == nil {
+		tfBlock = map[string]interface{}{}
+	}
+
+	switch widgetType {
+	case "alarm":
+		return widgetType, expandDashboardAlarmWidgetProperties(tfBlock), nil
+	case "explorer":
+		return widgetType, expandDashboardExplorerWidgetProperties(tfBlock, region), nil
+	case "log":
+		return widgetType, expandDashboardLogWidgetProperties(tfBlock, region), nil
+	case "metric":
+		properties, err := expandDashboardMetricWidgetProperties(tfBlock, region)
+
+		return widgetType, properties, err
+	default:
+		return widgetType, expandDashboardTextWidgetProperties(tfBlock), nil
+	}
+}
+
+func expandDashboardAlarmWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	apiObject := map[string]interface{}{
+		"alarms": flex.ExpandStringValueList(tfMap["alarms"].([]interface{})),
+	}
+
+	if v, ok := tfMap["sort_by"].(string); ok && v != "" {
+		apiObject["sortBy"] = v
+	}
+
+	if v, ok := tfMap["states"].([]interface{}); ok && len(v) > 0 {
+		apiObject["states"] = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardExplorerWidgetProperties(tfMap map[string]interface{}, region string) map[string]interface{} {
+	apiObject := map[string]interface{}{
+		"region": region,
+	}
+
+	var labels []interface{}
+	for _, tfMapRaw := range tfMap["label"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		label := map[string]interface{}{
+			"key": tfMap["key"].(string),
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			label["value"] = v
+		}
+
+		labels = append(labels, label)
+	}
+	apiObject["labels"] = labels
+
+	var metrics []interface{}
+	for _, tfMapRaw := range tfMap["metric"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		metrics = append(metrics, map[string]interface{}{
+			"metricName":   tfMap["metric_name"].(string),
+			"resourceType": tfMap["resource_type"].(string),
+			"stat":         tfMap["stat"].(string),
+		})
+	}
+	apiObject["metrics"] = metrics
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		apiObject["period"] = v
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject["region"] = v
+	}
+
+	if v, ok := tfMap["split_by"].(string); ok && v != "" {
+		apiObject["splitBy"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	widgetOptions := map[string]interface{}{}
+
+	if v, ok := tfMap["legend_position"].(string); ok && v != "" {
+		widgetOptions["legend"] = map[string]interface{}{
+			"position": v,
+		}
+	}
+
+	if v, ok := tfMap["rows_per_page"].(int); ok && v != 0 {
+		widgetOptions["rowsPerPage"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		widgetOptions["stacked"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		widgetOptions["view"] = v
+	}
+
+	if v, ok := tfMap["widgets_per_row"].(int); ok && v != 0 {
+		widgetOptions["widgetsPerRow"] = v
+	}
+
+	if len(widgetOptions) > 0 {
+		apiObject["widgetOptions"] = widgetOptions
+	}
+
+	return apiObject
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) map[string]interface{} {
+	var sources []string
+	for _, v := range flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})) {
+		sources = append(sources, fmt.Sprintf("SOURCE '%s'", v))
+	}
+
+	apiObject := map[string]interface{}{
+		"query":  strings.Join(append(sources, tfMap["query"].(string)), " | "),
+		"region": region,
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject["region"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		apiObject["stacked"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		apiObject["view"] = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (map[string]interface{}, error) {
+	var metrics []interface{}
+	ids := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfMap["metric_query"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id := tfMap["id"].(string)
+
+		if _, ok := ids[id]; ok {
+			return nil, fmt.Errorf("duplicate metric_query id (%s)", id)
+		}
+		ids[id] = struct{}{}
+
+		if err := validateMetricDataQuery(tfMap); err != nil {
+			return nil, fmt.Errorf("metric_query (%s): %w", id, err)
+		}
+
+		metric, err := expandDashboardMetricWidgetMetric(tfMap)
+
+		if err != nil {
+			return nil, fmt.Errorf("metric_query (%s): %w", id, err)
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	apiObject := map[string]interface{}{
+		"metrics": metrics,
+		"region":  region,
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		apiObject["period"] = v
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject["region"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		apiObject["stacked"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		apiObject["stat"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		apiObject["view"] = v
+	}
+
+	return apiObject, nil
+}
+
+// expandDashboardMetricWidgetMetric returns the dashboard body metrics array entry for a metric_query.
+// A metric is written as [namespace, metric name, dimension name, dimension value, ..., options]
+// and an expression as [options].
+func expandDashboardMetricWidgetMetric(tfMap map[string]interface{}) ([]interface{}, error) {
+	options := map[string]interface{}{
+		"id": tfMap["id"].(string),
+	}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		options["accountId"] = v
+	}
+
+	if v, ok := tfMap["label"].(string); ok && v != "" {
+		options["label"] = v
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		options["period"] = v
+	}
+
+	if v, ok := tfMap["visible"].(bool); ok && !v {
+		options["visible"] = v
+	}
+
+	if v, ok := tfMap["expression"].(string); ok && v != "" {
+		options["expression"] = v
+
+		return []interface{}{options}, nil
+	}
+
+	v, ok := tfMap["metric"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil, fmt.Errorf("one of `expression` or `metric` must be specified")
+	}
+
+	tfMap = v[0].(map[string]interface{})
+
+	namespace, _ := tfMap["namespace"].(string)
+	if namespace == "" {
+		return nil, fmt.Errorf("metric: `namespace` must be specified")
+	}
+
+	metric := []interface{}{namespace, tfMap["metric_name"].(string)}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		names := make([]string, 0, len(v))
+		for k := range v {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+
+		for _, k := range names {
+			metric = append(metric, k, v[k].(string))
+		}
+	}
+
+	// A metric's period takes precedence over the metric_query's.
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		options["period"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		options["stat"] = v
+	}
+
+	return append(metric, options), nil
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	apiObject := map[string]interface{}{
+		"markdown": tfMap["markdown"].(string),
+	}
+
+	if v, ok := tfMap["background"].(string); ok && v != "" {
+		apiObject["background"] = v
+	}
+
+	return apiObject
+}
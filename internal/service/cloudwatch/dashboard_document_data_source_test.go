@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccDashboardDocumentDataSourceConfig_basic_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalidMetricQuery(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidMetricQuery,
+				ExpectError: regexache.MustCompile(`widget\[0\]: metric_query \(e1\): No metric_query may have both`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_metricUnit(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_metricUnit,
+				ExpectError: regexache.MustCompile(`An argument named "unit" is not expected here`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+				),
+			},
+			{
+				Config:   testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  period_override = "inherit"
+
+  widget {
+    width = 12
+
+    metric {
+      title  = "CPU"
+      region = "us-west-2"
+
+      metric_query {
+        id      = "m1"
+        visible = false
+
+        metric {
+          namespace   = "AWS/EC2"
+          metric_name = "CPUUtilization"
+          period      = 300
+          stat        = "Average"
+
+          dimensions = {
+            InstanceId           = "i-1234567890abcdef0"
+            AutoScalingGroupName = "example"
+          }
+        }
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "m1 * 2"
+        label      = "Doubled"
+      }
+    }
+  }
+
+  widget {
+    width  = 12
+    height = 3
+
+    text {
+      markdown = "# Example"
+    }
+  }
+
+  widget {
+    width = 8
+
+    log {
+      log_group_names = ["/app/a", "/app/b"]
+      query           = "fields @timestamp, @message | sort @timestamp desc"
+      region          = "us-west-2"
+      view            = "table"
+    }
+  }
+
+  widget {
+    alarm {
+      alarms = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"]
+      states = ["ALARM"]
+    }
+  }
+
+  widget {
+    width = 24
+
+    explorer {
+      region = "us-west-2"
+      view   = "timeSeries"
+
+      metric {
+        metric_name   = "CPUUtilization"
+        resource_type = "AWS::EC2::Instance"
+        stat          = "Average"
+      }
+
+      label {
+        key   = "Environment"
+        value = "production"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_basic_ExpectedJSON = `{
+  "periodOverride": "inherit",
+  "widgets": [
+    {
+      "type": "metric",
+      "x": 0,
+      "y": 0,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/EC2", "CPUUtilization", "AutoScalingGroupName", "example", "InstanceId", "i-1234567890abcdef0", {"id": "m1", "period": 300, "stat": "Average", "visible": false}],
+          [{"expression": "m1 * 2", "id": "e1", "label": "Doubled"}]
+        ],
+        "region": "us-west-2",
+        "title": "CPU"
+      }
+    },
+    {
+      "type": "text",
+      "x": 12,
+      "y": 0,
+      "width": 12,
+      "height": 3,
+      "properties": {
+        "markdown": "# Example"
+      }
+    },
+    {
+      "type": "log",
+      "x": 0,
+      "y": 6,
+      "width": 8,
+      "height": 6,
+      "properties": {
+        "query": "SOURCE '/app/a' | SOURCE '/app/b' | fields @timestamp, @message | sort @timestamp desc",
+        "region": "us-west-2",
+        "view": "table"
+      }
+    },
+    {
+      "type": "alarm",
+      "x": 8,
+      "y": 6,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"],
+        "states": ["ALARM"]
+      }
+    },
+    {
+      "type": "explorer",
+      "x": 0,
+      "y": 12,
+      "width": 24,
+      "height": 6,
+      "properties": {
+        "labels": [{"key": "Environment", "value": "production"}],
+        "metrics": [{"metricName": "CPUUtilization", "resourceType": "AWS::EC2::Instance", "stat": "Average"}],
+        "region": "us-west-2",
+        "widgetOptions": {"view": "timeSeries"}
+      }
+    }
+  ]
+}`
+
+const testAccDashboardDocumentDataSourceConfig_invalidMetricQuery = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric_query {
+        id         = "e1"
+        expression = "SUM(METRICS())"
+
+        metric {
+          namespace   = "AWS/EC2"
+          metric_name = "CPUUtilization"
+          period      = 300
+          stat        = "Average"
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_metricUnit = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric_query {
+        id = "m1"
+
+        metric {
+          namespace   = "AWS/EC2"
+          metric_name = "CPUUtilization"
+          period      = 300
+          stat        = "Average"
+          unit        = "Percent"
+        }
+      }
+    }
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+
+    metric {
+      title = %[1]q
+      stat  = "Average"
+
+      metric_query {
+        id = "m1"
+
+        metric {
+          namespace   = "AWS/EC2"
+          metric_name = "CPUUtilization"
+          period      = 300
+          stat        = "Average"
+
+          dimensions = {
+            InstanceId = "i-012345"
+          }
+        }
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    text {
+      markdown = "Hello world"
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
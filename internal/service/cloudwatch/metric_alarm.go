@@ -136,94 +136,7 @@ func resourceMetricAlarm() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"metric_name"},
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"account_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 255),
-						},
-						"expression": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1024),
-						},
-						"id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringLenBetween(1, 255),
-						},
-						"metric": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"dimensions": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"metric_name": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringLenBetween(1, 255),
-									},
-									"namespace": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: validation.All(
-											validation.StringLenBetween(1, 255),
-											validation.StringMatch(regexache.MustCompile(`[^:].*`), "must not contain colon characters"),
-										),
-									},
-									"period": {
-										Type:     schema.TypeInt,
-										Required: true,
-										ValidateFunc: validation.Any(
-											validation.IntInSlice([]int{1, 5, 10, 30}),
-											validation.IntDivisibleBy(60),
-										),
-									},
-									"stat": {
-										Type:     schema.TypeString,
-										Required: true,
-										ValidateDiagFunc: validation.AnyDiag(
-											enum.Validate[types.Statistic](),
-											validation.ToDiagFunc(
-												validation.StringMatch(
-													// doesn't catch: PR with %-values provided, TM/WM/PR/TC/TS with no values provided
-													regexache.MustCompile(`^((p|(tm)|(wm)|(tc)|(ts))((\d{1,2}(\.\d{1,2})?)|(100))|(IQM)|(((TM)|(WM)|(PR)|(TC)|(TS)))\((\d+(\.\d+)?%?)?:(\d+(\.\d+)?%?)?\))$`),
-													"invalid statistic, see: https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/Statistics-definitions.html",
-												),
-											),
-										),
-									},
-									"unit": {
-										Type:             schema.TypeString,
-										Optional:         true,
-										ValidateDiagFunc: enum.Validate[types.StandardUnit](),
-									},
-								},
-							},
-						},
-						"label": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"period": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							ValidateFunc: validation.Any(
-								validation.IntInSlice([]int{1, 5, 10, 30}),
-								validation.IntDivisibleBy(60),
-							),
-						},
-						"return_data": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-					},
+					Schema: metricAlarmMetricQuerySchema(),
 				},
 			},
 			"namespace": {
@@ -301,13 +214,8 @@ func resourceMetricAlarm() *schema.Resource {
 
 				if v := diff.Get("metric_query"); v != nil {
 					for _, v := range v.(*schema.Set).List() {
-						tfMap := v.(map[string]interface{})
-						if v, ok := tfMap["expression"]; ok && v.(string) != "" {
-							if v := tfMap["metric"]; v != nil {
-								if len(v.([]interface{})) > 0 {
-									return errors.New("No metric_query may have both `expression` and a `metric` specified")
-								}
-							}
+						if err := validateMetricDataQuery(v.(map[string]interface{})); err != nil {
+							return err
 						}
 					}
 				}
@@ -318,6 +226,118 @@ func resourceMetricAlarm() *schema.Resource {
 	}
 }
 
+// metricDataQuerySchema returns the schema of a metric data query.
+// It is shared by the metric_query arguments of metric alarms and the metric widgets of dashboard documents.
+func metricDataQuerySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 255),
+		},
+		"expression": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1024),
+		},
+		"id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 255),
+		},
+		"metric": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dimensions": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"metric_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+					"namespace": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ValidateFunc: validation.All(
+							validation.StringLenBetween(1, 255),
+							validation.StringMatch(regexache.MustCompile(`[^:].*`), "must not contain colon characters"),
+						),
+					},
+					"period": {
+						Type:     schema.TypeInt,
+						Required: true,
+						ValidateFunc: validation.Any(
+							validation.IntInSlice([]int{1, 5, 10, 30}),
+							validation.IntDivisibleBy(60),
+						),
+					},
+					"stat": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateDiagFunc: validation.AnyDiag(
+							enum.Validate[types.Statistic](),
+							validation.ToDiagFunc(
+								validation.StringMatch(
+									// doesn't catch: PR with %-values provided, TM/WM/PR/TC/TS with no values provided
+									regexache.MustCompile(`^((p|(tm)|(wm)|(tc)|(ts))((\d{1,2}(\.\d{1,2})?)|(100))|(IQM)|(((TM)|(WM)|(PR)|(TC)|(TS)))\((\d+(\.\d+)?%?)?:(\d+(\.\d+)?%?)?\))$`),
+									"invalid statistic, see: https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/Statistics-definitions.html",
+								),
+							),
+						),
+					},
+					"unit": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: enum.Validate[types.StandardUnit](),
+					},
+				},
+			},
+		},
+		"label": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"period": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				validation.IntInSlice([]int{1, 5, 10, 30}),
+				validation.IntDivisibleBy(60),
+			),
+		},
+	}
+}
+
+func metricAlarmMetricQuerySchema() map[string]*schema.Schema {
+	s := metricDataQuerySchema()
+	s["return_data"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return s
+}
+
+// validateMetricDataQuery checks the rules for a metric data query that can't be expressed in its schema.
+func validateMetricDataQuery(tfMap map[string]interface{}) error {
+	if v, ok := tfMap["expression"]; ok && v.(string) != "" {
+		if v := tfMap["metric"]; v != nil {
+			if len(v.([]interface{})) > 0 {
+				return errors.New("No metric_query may have both `expression` and a `metric` specified")
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceMetricAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
@@ -0,0 +1,664 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_ecs_container_definitions_document", name="Container Definitions Document")
+func dataSourceContainerDefinitionsDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainerDefinitionsDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			secretSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value_from": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				}
+			}
+
+			return map[string]*schema.Schema{
+				"container": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"cpu": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"depends_on": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"condition": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+										},
+										"container_name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"docker_labels": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"entry_point": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"environment": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"value": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"environment_file": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Default:      ecs.EnvironmentFileTypeS3,
+											ValidateFunc: validation.StringInSlice(ecs.EnvironmentFileType_Values(), false),
+										},
+										"value": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"essential": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"firelens_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"options": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(ecs.FirelensConfigurationType_Values(), false),
+										},
+									},
+								},
+							},
+							"health_check": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"command": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"interval": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      30,
+											ValidateFunc: validation.IntBetween(5, 300),
+										},
+										"retries": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      3,
+											ValidateFunc: validation.IntBetween(1, 10),
+										},
+										"start_period": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(0, 300),
+										},
+										"timeout": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      5,
+											ValidateFunc: validation.IntBetween(2, 120),
+										},
+									},
+								},
+							},
+							"hostname": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"image": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"log_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"log_driver": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+										},
+										"options": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"secret_option": secretSchema(),
+									},
+								},
+							},
+							"memory": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(6),
+							},
+							"memory_reservation": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(6),
+							},
+							"mount_point": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"container_path": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"read_only": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"source_volume": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"port_mapping": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"app_protocol": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+										},
+										"container_port": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IsPortNumber,
+										},
+										"container_port_range": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"host_port": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IsPortNumberOrZero,
+										},
+										"name": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"protocol": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Default:      ecs.TransportProtocolTcp,
+											ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+										},
+									},
+								},
+							},
+							"privileged": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"readonly_root_filesystem": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"secret": secretSchema(),
+							"start_timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"stop_timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(1, 120),
+							},
+							"ulimit": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"hard_limit": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+										},
+										"soft_limit": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+									},
+								},
+							},
+							"user": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"volumes_from": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"read_only": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"source_container": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"working_directory": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"json": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(ecs.NetworkMode_Values(), false),
+				},
+			}
+		},
+	}
+}
+
+func dataSourceContainerDefinitionsDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var definitions containerDefinitions
+	names := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get("container").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		if _, ok := names[name]; ok {
+			return sdkdiag.AppendErrorf(diags, "writing ECS Container Definitions Document: duplicate container name (%s)", name)
+		}
+		names[name] = struct{}{}
+
+		definitions = append(definitions, expandContainerDefinitionsDocumentContainer(tfMap))
+	}
+
+	// Canonicalize the definitions in the same way as the aws_ecs_task_definition resource compares them
+	// so that a task definition using the document has no spurious differences.
+	if err := definitions.Reduce(d.Get("network_mode").(string) == ecs.NetworkModeAwsvpc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing ECS Container Definitions Document: %s", err)
+	}
+
+	v, err := flattenContainerDefinitions(definitions)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing ECS Container Definitions Document: %s", err)
+	}
+
+	jsonString, err := structure.NormalizeJsonString(v)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing ECS Container Definitions Document: %s", err)
+	}
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+func expandContainerDefinitionsDocumentContainer(tfMap map[string]interface{}) *ecs.ContainerDefinition {
+	apiObject := &ecs.ContainerDefinition{
+		Essential: aws.Bool(tfMap["essential"].(bool)),
+		Image:     aws.String(tfMap["image"].(string)),
+		Name:      aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringListEmpty(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+		apiObject.Cpu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.DependsOn = append(apiObject.DependsOn, &ecs.ContainerDependency{
+				Condition:     aws.String(tfMap["condition"].(string)),
+				ContainerName: aws.String(tfMap["container_name"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.DockerLabels = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EntryPoint = flex.ExpandStringListEmpty(v)
+	}
+
+	if v, ok := tfMap["environment"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+				Name:  aws.String(tfMap["name"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["environment_file"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.EnvironmentFiles = append(apiObject.EnvironmentFiles, &ecs.EnvironmentFile{
+				Type:  aws.String(tfMap["type"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["firelens_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.FirelensConfiguration = &ecs.FirelensConfiguration{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.FirelensConfiguration.Options = flex.ExpandStringMap(v)
+		}
+	}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.HealthCheck = &ecs.HealthCheck{
+			Command: flex.ExpandStringListEmpty(tfMap["command"].([]interface{})),
+		}
+
+		if v, ok := tfMap["interval"].(int); ok && v != 0 {
+			apiObject.HealthCheck.Interval = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["retries"].(int); ok && v != 0 {
+			apiObject.HealthCheck.Retries = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+			apiObject.HealthCheck.StartPeriod = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["timeout"].(int); ok && v != 0 {
+			apiObject.HealthCheck.Timeout = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["hostname"].(string); ok && v != "" {
+		apiObject.Hostname = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.LogConfiguration = &ecs.LogConfiguration{
+			LogDriver: aws.String(tfMap["log_driver"].(string)),
+		}
+
+		if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.LogConfiguration.Options = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["secret_option"].([]interface{}); ok && len(v) > 0 {
+			apiObject.LogConfiguration.SecretOptions = expandContainerDefinitionsDocumentSecrets(v)
+		}
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+		apiObject.MemoryReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.MountPoints = append(apiObject.MountPoints, &ecs.MountPoint{
+				ContainerPath: aws.String(tfMap["container_path"].(string)),
+				ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+				SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			portMapping := &ecs.PortMapping{
+				Protocol: aws.String(tfMap["protocol"].(string)),
+			}
+
+			if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+				portMapping.AppProtocol = aws.String(v)
+			}
+
+			if v, ok := tfMap["container_port"].(int); ok && v != 0 {
+				portMapping.ContainerPort = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["container_port_range"].(string); ok && v != "" {
+				portMapping.ContainerPortRange = aws.String(v)
+			}
+
+			if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+				portMapping.HostPort = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				portMapping.Name = aws.String(v)
+			}
+
+			apiObject.PortMappings = append(apiObject.PortMappings, portMapping)
+		}
+	}
+
+	if v, ok := tfMap["privileged"].(bool); ok && v {
+		apiObject.Privileged = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+		apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["secret"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Secrets = expandContainerDefinitionsDocumentSecrets(v)
+	}
+
+	if v, ok := tfMap["start_timeout"].(int); ok && v != 0 {
+		apiObject.StartTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stop_timeout"].(int); ok && v != 0 {
+		apiObject.StopTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["ulimit"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.Ulimits = append(apiObject.Ulimits, &ecs.Ulimit{
+				HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+				Name:      aws.String(tfMap["name"].(string)),
+				SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+			})
+		}
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	if v, ok := tfMap["volumes_from"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.VolumesFrom = append(apiObject.VolumesFrom, &ecs.VolumeFrom{
+				ReadOnly:        aws.Bool(tfMap["read_only"].(bool)),
+				SourceContainer: aws.String(tfMap["source_container"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+		apiObject.WorkingDirectory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionsDocumentSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSContainerDefinitionsDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_container_definitions_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccContainerDefinitionsDocumentDataSourceConfig_basic_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDocumentDataSource_duplicateName(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerDefinitionsDocumentDataSourceConfig_duplicateName,
+				ExpectError: regexache.MustCompile(`duplicate container name \(app\)`),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDocumentDataSource_taskDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+				),
+			},
+			{
+				Config:   testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_basic = `
+data "aws_ecs_container_definitions_document" "test" {
+  network_mode = "awsvpc"
+
+  container {
+    name   = "app"
+    image  = "nginx:latest"
+    cpu    = 0
+    memory = 128
+
+    port_mapping {
+      container_port = 80
+    }
+
+    environment {
+      name  = "B"
+      value = "2"
+    }
+
+    environment {
+      name  = "A"
+      value = "1"
+    }
+
+    secret {
+      name       = "TOKEN"
+      value_from = "arn:aws:ssm:us-west-2:123456789012:parameter/token"
+    }
+
+    log_configuration {
+      log_driver = "awsfirelens"
+      options = {
+        Name = "cloudwatch"
+      }
+    }
+
+    health_check {
+      command  = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+      interval = 30
+    }
+
+    depends_on {
+      container_name = "log_router"
+      condition      = "START"
+    }
+
+    ulimit {
+      name       = "nofile"
+      soft_limit = 1024
+      hard_limit = 4096
+    }
+
+    mount_point {
+      source_volume  = "data"
+      container_path = "/data"
+    }
+  }
+
+  container {
+    name      = "log_router"
+    image     = "amazon/aws-for-fluent-bit:stable"
+    essential = false
+
+    firelens_configuration {
+      type = "fluentbit"
+      options = {
+        "enable-ecs-log-metadata" = "true"
+      }
+    }
+  }
+}
+`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_basic_ExpectedJSON = `[
+  {
+    "essential": false,
+    "firelensConfiguration": {
+      "options": {
+        "enable-ecs-log-metadata": "true"
+      },
+      "type": "fluentbit"
+    },
+    "image": "amazon/aws-for-fluent-bit:stable",
+    "name": "log_router"
+  },
+  {
+    "dependsOn": [{"condition": "START", "containerName": "log_router"}],
+    "environment": [
+      {"name": "A", "value": "1"},
+      {"name": "B", "value": "2"}
+    ],
+    "essential": true,
+    "healthCheck": {
+      "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+      "interval": 30,
+      "retries": 3,
+      "timeout": 5
+    },
+    "image": "nginx:latest",
+    "logConfiguration": {
+      "logDriver": "awsfirelens",
+      "options": {
+        "Name": "cloudwatch"
+      }
+    },
+    "memory": 128,
+    "mountPoints": [{"containerPath": "/data", "readOnly": false, "sourceVolume": "data"}],
+    "name": "app",
+    "portMappings": [{"containerPort": 80, "hostPort": 80}],
+    "secrets": [{"name": "TOKEN", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/token"}],
+    "ulimits": [{"hardLimit": 4096, "name": "nofile", "softLimit": 1024}]
+  }
+]`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_duplicateName = `
+data "aws_ecs_container_definitions_document" "test" {
+  container {
+    name  = "app"
+    image = "nginx:latest"
+  }
+
+  container {
+    name  = "app"
+    image = "nginx:latest"
+  }
+}
+`
+
+func testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ecs_container_definitions_document" "test" {
+  network_mode = "awsvpc"
+
+  container {
+    name  = "app"
+    image = "nginx:latest"
+
+    port_mapping {
+      container_port = 80
+    }
+
+    environment {
+      name  = "PORT"
+      value = "80"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+  }
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+  container_definitions    = data.aws_ecs_container_definitions_document.test.json
+}
+`, rName)
+}
@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceContainerDefinition,
 			TypeName: "aws_ecs_container_definition",
 		},
+		{
+			Factory:  dataSourceContainerDefinitionsDocument,
+			TypeName: "aws_ecs_container_definitions_document",
+			Name:     "Container Definitions Document",
+		},
 		{
 			Factory:  DataSourceService,
 			TypeName: "aws_ecs_service",
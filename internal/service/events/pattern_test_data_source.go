@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern_test", name="Pattern Test")
+func dataSourcePatternTest() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatternTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_matched": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEventPatternValue(),
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePatternTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pattern, err := eventpattern.Parse(d.Get("event_pattern").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing EventBridge Event Pattern: invalid event pattern: %s", err)
+	}
+
+	allMatched := true
+	var results []interface{}
+
+	for i, v := range d.Get("events").([]interface{}) {
+		event, _ := v.(string)
+
+		matched, err := pattern.MatchEvent(event)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "testing EventBridge Event Pattern: events[%d]: %s", i, err)
+		}
+
+		allMatched = allMatched && matched
+		results = append(results, map[string]interface{}{
+			"event":   event,
+			"matched": matched,
+		})
+	}
+
+	d.SetId("-")
+	d.Set("all_matched", allMatched)
+	if err := d.Set("results", results); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
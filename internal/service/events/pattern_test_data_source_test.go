@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsPatternTestDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_test.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternTestDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_matched", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.matched", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "results.0.event", dataSourceName, "events.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternTestDataSource_invalidPattern(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternTestDataSourceConfig_invalidPattern,
+				ExpectError: regexache.MustCompile(`invalid event pattern: detail.state\[0\]: numeric: unsupported operator \(!=\)`),
+			},
+		},
+	})
+}
+
+const testAccPatternTestDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern_test" "test" {
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+    detail = {
+      state = [{ "anything-but" = "terminated" }]
+      "source-ip" = [{ cidr = "10.0.0.0/16" }]
+    }
+  })
+
+  events = [
+    jsonencode({
+      source = "aws.ec2"
+      detail = {
+        state       = "running"
+        "source-ip" = "10.0.1.10"
+      }
+    }),
+    jsonencode({
+      source = "aws.ec2"
+      detail = {
+        state       = "stopped"
+        "source-ip" = "10.0.255.1"
+      }
+    }),
+    jsonencode({
+      source = "aws.ec2"
+      detail = {
+        state       = "terminated"
+        "source-ip" = "10.0.1.10"
+      }
+    }),
+  ]
+}
+`
+
+const testAccPatternTestDataSourceConfig_invalidPattern = `
+data "aws_cloudwatch_event_pattern_test" "test" {
+  event_pattern = jsonencode({
+    detail = {
+      state = [{ numeric = ["!=", 1] }]
+    }
+  })
+
+  events = [
+    jsonencode({
+      detail = {
+        state = 1
+      }
+    }),
+  ]
+}
+`
@@ -33,6 +33,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceConnection,
 			TypeName: "aws_cloudwatch_event_connection",
 		},
+		{
+			Factory:  dataSourcePatternTest,
+			TypeName: "aws_cloudwatch_event_pattern_test",
+			Name:     "Pattern Test",
+		},
 		{
 			Factory:  DataSourceSource,
 			TypeName: "aws_cloudwatch_event_source",
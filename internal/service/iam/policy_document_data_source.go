@@ -163,36 +163,8 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	mergedDoc := &IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
-		// generate sid map to assure there are no duplicates in source jsons
-		sidMap := make(map[string]struct{})
-		for _, stmt := range mergedDoc.Statements {
-			if stmt.Sid != "" {
-				sidMap[stmt.Sid] = struct{}{}
-			}
-		}
-
-		// merge sourceDocs in order specified
-		for sourceJSONIndex, sourceJSON := range v.([]interface{}) {
-			if sourceJSON == nil {
-				continue
-			}
-
-			sourceDoc := &IAMPolicyDoc{}
-			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
-				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: %s", sourceJSONIndex, err)
-			}
-
-			// assure all statements in sourceDoc are unique before merging
-			for stmtIndex, stmt := range sourceDoc.Statements {
-				if stmt.Sid != "" {
-					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceJSONIndex, stmt.Sid, stmtIndex)
-					}
-					sidMap[stmt.Sid] = struct{}{}
-				}
-			}
-
-			mergedDoc.Merge(sourceDoc)
+		if err := mergedDoc.MergeSourceDocuments(policyDocumentsFromConfig(v.([]interface{}))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: %s", err)
 		}
 	}
 
@@ -287,16 +259,8 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	// merge override_policy_documents policies into mergedDoc in order specified
 	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]interface{})) > 0 {
-		for overrideJSONIndex, overrideJSON := range v.([]interface{}) {
-			if overrideJSON == nil {
-				continue
-			}
-			overrideDoc := &IAMPolicyDoc{}
-			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
-				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
-			}
-
-			mergedDoc.Merge(overrideDoc)
+		if err := mergedDoc.MergeOverrideDocuments(policyDocumentsFromConfig(v.([]interface{}))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: %s", err)
 		}
 	}
 
@@ -313,6 +277,20 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// policyDocumentsFromConfig returns the JSON policy documents from a list of configured values.
+// Null values are returned as empty strings so that document indexes are preserved.
+func policyDocumentsFromConfig(tfList []interface{}) []string {
+	apiObjects := make([]string, len(tfList))
+
+	for i, v := range tfList {
+		if v, ok := v.(string); ok {
+			apiObjects[i] = v
+		}
+	}
+
+	return apiObjects
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"slices"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation", name="Policy Evaluation")
+func dataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `One or more names of actions, like "iam:CreateUser", that should be evaluated.`,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  `ARN of the principal making the evaluated requests. It is matched against the Principal elements of resource_policy_json and sets the "aws:PrincipalArn" and "aws:PrincipalAccount" context keys, unless they are given in a context block.`,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The key name of the context entry, such as "aws:CurrentTime".`,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `One or more values to assign to the context key, given as strings in a syntax appropriate for the condition operators that use the key.`,
+						},
+					},
+				},
+				Description: `Each block specifies one item of additional context entry to include in the evaluated requests. These are the additional properties used in the 'Condition' element of an IAM policy, and in dynamic value interpolations.`,
+			},
+			"identity_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Identity-based policies attached to the principal making the evaluated requests.`,
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Permissions boundary policies of the principal making the evaluated requests.`,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `ARNs of specific resources to use as the targets of the specified actions. If not specified, "*" is used.`,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `A resource policy to associate with all of the target resources.`,
+			},
+			"service_control_policy_level": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policies_json": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsJSON,
+							},
+							Description: `AWS Organizations service control policies attached to the level.`,
+						},
+					},
+				},
+				Description: `Each block specifies the service control policies attached to one level of the AWS Organizations hierarchy that contains the account of the principal making the evaluated requests: the root, each OU and the account itself. A service control policy at every level must allow the request.`,
+			},
+
+			// Result Attributes
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `A summary of the results attribute which is true if all of the results have decision "allowed", and false otherwise.`,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the action whose evaluation this result is describing.`,
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `A summary of attribute "decision" which is true only if the decision is "allowed".`,
+						},
+						"deciding_policy_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the policy that determined the decision: "identity", "permissions-boundary", "resource", or "service-control".`,
+						},
+						"deciding_statement_sid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The Sid of the statement that explicitly allowed or denied the request. Empty for an implicit deny or a statement without a Sid.`,
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The decision, using the same keywords as the IAM policy simulator: "allowed", "explicitDeny", or "implicitDeny".`,
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effect": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The effect of the statement: "Allow" or "Deny".`,
+									},
+									"sid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The Sid of the statement.`,
+									},
+									"source_policy_index": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `Index of the policy containing the statement in the list of policies of its type.`,
+									},
+									"source_policy_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The type of the policy containing the statement.`,
+									},
+								},
+							},
+							Description: `Statements that apply to the request.`,
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `Set of context entry keys that were needed by the conditions of one or more of the applicable statements but not included in the request.`,
+						},
+						"resource_arn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `ARN of the resource that the action was evaluated against.`,
+						},
+					},
+				},
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Do not use`,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &policyEvaluationInput{
+		CallerARN:                   d.Get("caller_arn").(string),
+		Context:                     make(map[string][]string),
+		IdentityPolicies:            flex.ExpandStringValueListEmpty(d.Get("identity_policies_json").([]interface{})),
+		PermissionsBoundaryPolicies: flex.ExpandStringValueListEmpty(d.Get("permissions_boundary_policies_json").([]interface{})),
+		ResourcePolicy:              d.Get("resource_policy_json").(string),
+	}
+
+	for _, levelRaw := range d.Get("service_control_policy_level").([]interface{}) {
+		levelRaw := levelRaw.(map[string]interface{})
+		input.ServiceControlPolicies = append(input.ServiceControlPolicies, flex.ExpandStringValueListEmpty(levelRaw["policies_json"].([]interface{})))
+	}
+
+	for _, entryRaw := range d.Get("context").(*schema.Set).List() {
+		entryRaw := entryRaw.(map[string]interface{})
+		input.Context[entryRaw["key"].(string)] = flex.ExpandStringValueSet(entryRaw["values"].(*schema.Set))
+	}
+
+	evaluator, err := newPolicyEvaluator(input)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+	}
+
+	actionNames := flex.ExpandStringValueSet(d.Get("action_names").(*schema.Set))
+	slices.Sort(actionNames)
+
+	resourceARNs := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	if len(resourceARNs) == 0 {
+		resourceARNs = []string{"*"}
+	}
+	slices.Sort(resourceARNs)
+
+	// "all" are allowed only if there is at least one result and no other
+	// results were denied.
+	allowedCount := 0
+	deniedCount := 0
+
+	var rawResults []interface{}
+	for _, actionName := range actionNames {
+		for _, resourceARN := range resourceARNs {
+			result, err := evaluator.Evaluate(actionName, resourceARN)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies (%s on %s): %s", actionName, resourceARN, err)
+			}
+
+			allowed := result.Decision == awstypes.PolicyEvaluationDecisionTypeAllowed
+			if allowed {
+				allowedCount++
+			} else {
+				deniedCount++
+			}
+
+			rawResult := map[string]interface{}{
+				"action_name":          result.Action,
+				"allowed":              allowed,
+				"deciding_policy_type": result.DecidingPolicyType,
+				"decision":             string(result.Decision),
+				"missing_context_keys": result.MissingContextKeys,
+				"resource_arn":         result.Resource,
+			}
+			if v := result.DecidingStatement; v != nil {
+				rawResult["deciding_statement_sid"] = v.Sid
+			}
+
+			rawMatchedStmts := make([]interface{}, len(result.MatchedStatements))
+			for i, stmt := range result.MatchedStatements {
+				rawMatchedStmts[i] = map[string]interface{}{
+					"effect":              stmt.Effect,
+					"sid":                 stmt.Sid,
+					"source_policy_index": stmt.PolicyIndex,
+					"source_policy_type":  stmt.PolicyType,
+				}
+			}
+			rawResult["matched_statements"] = rawMatchedStmts
+
+			rawResults = append(rawResults, rawResult)
+		}
+	}
+	d.Set("results", rawResults)
+	d.Set("all_allowed", allowedCount > 0 && deniedCount == 0)
+
+	d.SetId("-")
+
+	return diags
+}
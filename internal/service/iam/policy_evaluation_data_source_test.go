@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:DeleteObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_statement_sid", "NoDelete"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.deciding_statement_sid", "S3"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.0.source_policy_index", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.0.source_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.action_name", "sqs:SendMessage"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.deciding_statement_sid", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_boundaryAndResourcePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_boundaryAndResourcePolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_policy_type", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_statement_sid", "Bucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.action_name", "s3:PutObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.deciding_policy_type", "permissions-boundary"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_missingContext(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_missingContext,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.missing_context_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "results.0.missing_context_keys.*", "aws:ResourceTag/Team"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_serviceControlPolicyLevels(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_serviceControlPolicyLevels,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "ec2:RunInstances"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_policy_type", "service-control"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.deciding_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.1.source_policy_index", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.1.source_policy_type", "service-control"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyEvaluationDataSourceConfig_basic = `
+data "aws_iam_policy_evaluation" "test" {
+  action_names = [
+    "s3:GetObject",
+    "s3:DeleteObject",
+    "sqs:SendMessage",
+  ]
+  resource_arns = ["arn:${data.aws_partition.current.partition}:s3:::example/key"]
+
+  identity_policies_json = [
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "S3"
+        Effect   = "Allow"
+        Action   = "s3:*"
+        Resource = "arn:${data.aws_partition.current.partition}:s3:::example/*"
+      }]
+    }),
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "NoDelete"
+        Effect   = "Deny"
+        Action   = "s3:Delete*"
+        Resource = "*"
+      }]
+    }),
+  ]
+}
+
+data "aws_partition" "current" {}
+`
+
+const testAccPolicyEvaluationDataSourceConfig_boundaryAndResourcePolicy = `
+data "aws_iam_policy_evaluation" "test" {
+  action_names  = ["s3:GetObject", "s3:PutObject"]
+  caller_arn    = "arn:${data.aws_partition.current.partition}:iam::123456789012:role/example"
+  resource_arns = ["arn:${data.aws_partition.current.partition}:s3:::example/key"]
+
+  identity_policies_json = [
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "S3"
+        Effect   = "Allow"
+        Action   = "s3:*"
+        Resource = "*"
+      }]
+    }),
+  ]
+
+  permissions_boundary_policies_json = [
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "ReadOnly"
+        Effect   = "Allow"
+        Action   = "s3:Get*"
+        Resource = "*"
+      }]
+    }),
+  ]
+
+  resource_policy_json = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "Bucket"
+      Effect    = "Allow"
+      Principal = { AWS = "123456789012" }
+      Action    = "s3:GetObject"
+      Resource  = "arn:${data.aws_partition.current.partition}:s3:::example/*"
+    }]
+  })
+}
+
+data "aws_partition" "current" {}
+`
+
+const testAccPolicyEvaluationDataSourceConfig_missingContext = `
+data "aws_iam_policy_evaluation" "test" {
+  action_names = ["ec2:StopInstances"]
+
+  identity_policies_json = [
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "Team"
+        Effect   = "Allow"
+        Action   = "ec2:StopInstances"
+        Resource = "*"
+        Condition = {
+          StringEquals = {
+            "aws:ResourceTag/Team" = "example"
+          }
+        }
+      }]
+    }),
+  ]
+}
+`
+
+const testAccPolicyEvaluationDataSourceConfig_serviceControlPolicyLevels = `
+data "aws_iam_policy_evaluation" "test" {
+  action_names = ["s3:GetObject", "ec2:RunInstances"]
+
+  identity_policies_json = [
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "All"
+        Effect   = "Allow"
+        Action   = "*"
+        Resource = "*"
+      }]
+    }),
+  ]
+
+  # Root.
+  service_control_policy_level {
+    policies_json = [
+      jsonencode({
+        Version = "2012-10-17"
+        Statement = [{
+          Sid      = "FullAWSAccess"
+          Effect   = "Allow"
+          Action   = "*"
+          Resource = "*"
+        }]
+      }),
+    ]
+  }
+
+  # OU.
+  service_control_policy_level {
+    policies_json = [
+      jsonencode({
+        Version = "2012-10-17"
+        Statement = [{
+          Sid      = "S3"
+          Effect   = "Allow"
+          Action   = "s3:*"
+          Resource = "*"
+        }]
+      }),
+    ]
+  }
+}
+`
@@ -0,0 +1,848 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// Types of the policies evaluated by policyEvaluator.
+const (
+	policyEvaluationPolicyTypeIdentity            = "identity"
+	policyEvaluationPolicyTypePermissionsBoundary = "permissions-boundary"
+	policyEvaluationPolicyTypeResource            = "resource"
+	policyEvaluationPolicyTypeServiceControl      = "service-control"
+)
+
+const (
+	policyEvaluationEffectAllow = "Allow"
+	policyEvaluationEffectDeny  = "Deny"
+)
+
+// Placeholders for the ${*} and ${?} policy variables, which represent literal
+// '*' and '?' characters rather than wildcards.
+const (
+	policyEvaluationLiteralAsterisk = '\uE000'
+	policyEvaluationLiteralQuestion = '\uE001'
+)
+
+type policyEvaluationInput struct {
+	// CallerARN is the ARN of the principal making the requests.
+	// It is matched against the Principal and NotPrincipal elements of the resource policy.
+	CallerARN string
+	// Context contains the values of the request's condition context keys.
+	Context                     map[string][]string
+	IdentityPolicies            []string
+	PermissionsBoundaryPolicies []string
+	ResourcePolicy              string
+	// ServiceControlPolicies contains the service control policies that apply to the principal's account,
+	// grouped by level of the AWS Organizations hierarchy: the root, each OU and the account itself.
+	ServiceControlPolicies [][]string
+}
+
+type policyEvaluationStatement struct {
+	Effect      string
+	PolicyIndex int
+	PolicyType  string
+	Sid         string
+}
+
+type policyEvaluationResult struct {
+	Action   string
+	Decision awstypes.PolicyEvaluationDecisionType
+	// DecidingPolicyType is the type of the policy that determined the decision.
+	DecidingPolicyType string
+	// DecidingStatement is the statement that determined the decision.
+	// It is nil for an implicit deny.
+	DecidingStatement  *policyEvaluationStatement
+	MatchedStatements  []policyEvaluationStatement
+	MissingContextKeys []string
+	Resource           string
+}
+
+// policyEvaluator evaluates requests against IAM policies without calling AWS.
+//
+// Requests are evaluated using the IAM policy evaluation logic for a request made within a single account
+// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_evaluation-logic.html):
+//
+//  1. An explicit deny in any policy denies the request.
+//  2. If service control policies are specified, a policy at every level of the AWS Organizations hierarchy must allow the request.
+//  3. An allow in the resource policy allows the request.
+//  4. If permissions boundaries are specified, one of them must allow the request.
+//  5. An allow in an identity policy allows the request.
+//
+// Session policies and cross-account access are not modeled.
+type policyEvaluator struct {
+	callerARN                   string
+	context                     map[string][]string
+	identityPolicies            []*IAMPolicyDoc
+	permissionsBoundaryPolicies []*IAMPolicyDoc
+	resourcePolicies            []*IAMPolicyDoc
+	serviceControlPolicies      [][]*IAMPolicyDoc
+}
+
+func newPolicyEvaluator(input *policyEvaluationInput) (*policyEvaluator, error) {
+	e := &policyEvaluator{
+		callerARN: input.CallerARN,
+		context:   make(map[string][]string, len(input.Context)),
+	}
+
+	// Condition context keys are not case-sensitive.
+	for k, v := range input.Context {
+		e.context[strings.ToLower(k)] = v
+	}
+
+	if v := input.CallerARN; v != "" {
+		if _, ok := e.context["aws:principalarn"]; !ok {
+			e.context["aws:principalarn"] = []string{v}
+		}
+
+		if arn, err := arn.Parse(v); err == nil && arn.AccountID != "" {
+			if _, ok := e.context["aws:principalaccount"]; !ok {
+				e.context["aws:principalaccount"] = []string{arn.AccountID}
+			}
+		}
+	}
+
+	var err error
+
+	if e.identityPolicies, err = unmarshalPolicyEvaluationDocuments(policyEvaluationPolicyTypeIdentity, input.IdentityPolicies); err != nil {
+		return nil, err
+	}
+
+	if e.permissionsBoundaryPolicies, err = unmarshalPolicyEvaluationDocuments(policyEvaluationPolicyTypePermissionsBoundary, input.PermissionsBoundaryPolicies); err != nil {
+		return nil, err
+	}
+
+	if v := input.ResourcePolicy; v != "" {
+		if e.resourcePolicies, err = unmarshalPolicyEvaluationDocuments(policyEvaluationPolicyTypeResource, []string{v}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Service control policies are numbered in order across all levels.
+	var serviceControlPolicies []string
+	for _, policies := range input.ServiceControlPolicies {
+		serviceControlPolicies = append(serviceControlPolicies, policies...)
+	}
+
+	docs, err := unmarshalPolicyEvaluationDocuments(policyEvaluationPolicyTypeServiceControl, serviceControlPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policies := range input.ServiceControlPolicies {
+		e.serviceControlPolicies = append(e.serviceControlPolicies, docs[:len(policies)])
+		docs = docs[len(policies):]
+	}
+
+	return e, nil
+}
+
+// Evaluate returns the decision for a request to perform the specified action on the specified resource.
+func (e *policyEvaluator) Evaluate(action, resource string) (*policyEvaluationResult, error) {
+	result := &policyEvaluationResult{
+		Action:   action,
+		Resource: resource,
+	}
+
+	type policyTypeMatch struct {
+		policyType string
+		// levels contains the policies of the type, grouped by level.
+		// Only service control policies have more than one level.
+		levels [][]*IAMPolicyDoc
+		// allow contains the first allowing statement at each level.
+		allow []*policyEvaluationStatement
+		deny  *policyEvaluationStatement
+	}
+
+	levels := func(policies []*IAMPolicyDoc) [][]*IAMPolicyDoc {
+		if len(policies) == 0 {
+			return nil
+		}
+
+		return [][]*IAMPolicyDoc{policies}
+	}
+
+	// In evaluation order.
+	matches := []*policyTypeMatch{
+		{policyType: policyEvaluationPolicyTypeServiceControl, levels: e.serviceControlPolicies},
+		{policyType: policyEvaluationPolicyTypeResource, levels: levels(e.resourcePolicies)},
+		{policyType: policyEvaluationPolicyTypeIdentity, levels: levels(e.identityPolicies)},
+		{policyType: policyEvaluationPolicyTypePermissionsBoundary, levels: levels(e.permissionsBoundaryPolicies)},
+	}
+
+	for _, m := range matches {
+		var index int
+
+		for _, policies := range m.levels {
+			allow, deny, err := e.match(m.policyType, policies, index, action, resource, result)
+
+			if err != nil {
+				return nil, err
+			}
+
+			m.allow = append(m.allow, allow)
+			if m.deny == nil {
+				m.deny = deny
+			}
+			index += len(policies)
+		}
+	}
+
+	// allowed returns whether every level of the policy type allows the request.
+	allowed := func(m *policyTypeMatch) bool {
+		return len(m.levels) > 0 && !slices.Contains(m.allow, nil)
+	}
+
+	for _, m := range matches {
+		if m.deny != nil {
+			result.Decision = awstypes.PolicyEvaluationDecisionTypeExplicitDeny
+			result.DecidingPolicyType = m.policyType
+			result.DecidingStatement = m.deny
+
+			return result, nil
+		}
+	}
+
+	serviceControl, resourceBased, identity, permissionsBoundary := matches[0], matches[1], matches[2], matches[3]
+
+	switch {
+	case len(serviceControl.levels) > 0 && !allowed(serviceControl):
+		result.Decision = awstypes.PolicyEvaluationDecisionTypeImplicitDeny
+		result.DecidingPolicyType = serviceControl.policyType
+	case allowed(resourceBased):
+		result.Decision = awstypes.PolicyEvaluationDecisionTypeAllowed
+		result.DecidingPolicyType = resourceBased.policyType
+		result.DecidingStatement = resourceBased.allow[0]
+	case len(permissionsBoundary.levels) > 0 && !allowed(permissionsBoundary):
+		result.Decision = awstypes.PolicyEvaluationDecisionTypeImplicitDeny
+		result.DecidingPolicyType = permissionsBoundary.policyType
+	case allowed(identity):
+		result.Decision = awstypes.PolicyEvaluationDecisionTypeAllowed
+		result.DecidingPolicyType = identity.policyType
+		result.DecidingStatement = identity.allow[0]
+	default:
+		result.Decision = awstypes.PolicyEvaluationDecisionTypeImplicitDeny
+		result.DecidingPolicyType = identity.policyType
+	}
+
+	return result, nil
+}
+
+// match returns the first allowing and first denying statements in the specified policies that apply to the request.
+// The policies are numbered from firstIndex.
+// All applicable statements and any missing context keys are recorded in the result.
+func (e *policyEvaluator) match(policyType string, policies []*IAMPolicyDoc, firstIndex int, action, resource string, result *policyEvaluationResult) (*policyEvaluationStatement, *policyEvaluationStatement, error) {
+	var allow, deny *policyEvaluationStatement
+
+	for i, policy := range policies {
+		if policy == nil {
+			continue
+		}
+
+		i += firstIndex
+
+		for _, statement := range policy.Statements {
+			ok, err := e.statementMatches(statement, policyType == policyEvaluationPolicyTypeResource, action, resource, result)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("evaluating %s policy %d statement (%s): %w", policyType, i, statement.Sid, err)
+			}
+
+			if !ok {
+				continue
+			}
+
+			v := policyEvaluationStatement{
+				Effect:      statement.Effect,
+				PolicyIndex: i,
+				PolicyType:  policyType,
+				Sid:         statement.Sid,
+			}
+			result.MatchedStatements = append(result.MatchedStatements, v)
+
+			switch statement.Effect {
+			case policyEvaluationEffectAllow:
+				if allow == nil {
+					allow = &v
+				}
+			case policyEvaluationEffectDeny:
+				if deny == nil {
+					deny = &v
+				}
+			}
+		}
+	}
+
+	return allow, deny, nil
+}
+
+func (e *policyEvaluator) statementMatches(statement *IAMPolicyStatement, checkPrincipal bool, action, resource string, result *policyEvaluationResult) (bool, error) {
+	if checkPrincipal && !e.principalMatches(statement) {
+		return false, nil
+	}
+
+	actionMatches := func(pattern string) bool {
+		return policyEvaluationWildcardMatch(pattern, action, true)
+	}
+
+	if !policyEvaluationElementMatches(statement.Actions, statement.NotActions, actionMatches, false) {
+		return false, nil
+	}
+
+	resourceMatches := func(pattern string) bool {
+		pattern, ok := e.resolveVariables(pattern)
+		return ok && policyEvaluationWildcardMatch(pattern, resource, false)
+	}
+
+	if !policyEvaluationElementMatches(statement.Resources, statement.NotResources, resourceMatches, true) {
+		return false, nil
+	}
+
+	// Evaluate all conditions so that all missing context keys are recorded.
+	matched := true
+
+	for _, condition := range statement.Conditions {
+		ok, err := e.conditionMatches(condition, result)
+
+		if err != nil {
+			return false, err
+		}
+
+		matched = matched && ok
+	}
+
+	return matched, nil
+}
+
+// policyEvaluationElementMatches matches a statement's Action/NotAction or Resource/NotResource elements.
+func policyEvaluationElementMatches(values, notValues interface{}, match func(string) bool, defaultMatch bool) bool {
+	switch {
+	case values != nil:
+		return slices.ContainsFunc(policyEvaluationStrings(values), match)
+	case notValues != nil:
+		return !slices.ContainsFunc(policyEvaluationStrings(notValues), match)
+	default:
+		return defaultMatch
+	}
+}
+
+func (e *policyEvaluator) principalMatches(statement *IAMPolicyStatement) bool {
+	switch {
+	case len(statement.Principals) > 0:
+		return e.principalSetMatches(statement.Principals)
+	case len(statement.NotPrincipals) > 0:
+		return !e.principalSetMatches(statement.NotPrincipals)
+	default:
+		return false
+	}
+}
+
+func (e *policyEvaluator) principalSetMatches(principals IAMPolicyStatementPrincipalSet) bool {
+	var callerAccountID string
+	if arn, err := arn.Parse(e.callerARN); err == nil {
+		callerAccountID = arn.AccountID
+	}
+
+	for _, principal := range principals {
+		for _, identifier := range policyEvaluationStrings(principal.Identifiers) {
+			if identifier == "*" {
+				return true
+			}
+
+			if e.callerARN == "" {
+				continue
+			}
+
+			if identifier == e.callerARN {
+				return true
+			}
+
+			// An account principal matches all principals in the account.
+			if principal.Type == "AWS" && callerAccountID != "" {
+				if identifier == callerAccountID {
+					return true
+				}
+
+				if arn, err := arn.Parse(identifier); err == nil && arn.Service == "iam" && arn.Resource == "root" && arn.AccountID == callerAccountID {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+type policyEvaluationComparator func(policyValue, requestValue string) (bool, error)
+
+type policyEvaluationConditionOperator struct {
+	compare policyEvaluationComparator
+	negated bool
+}
+
+var policyEvaluationConditionOperators = map[string]policyEvaluationConditionOperator{
+	"StringEquals":              {compare: policyEvaluationStringEquals},
+	"StringNotEquals":           {compare: policyEvaluationStringEquals, negated: true},
+	"StringEqualsIgnoreCase":    {compare: policyEvaluationStringEqualsIgnoreCase},
+	"StringNotEqualsIgnoreCase": {compare: policyEvaluationStringEqualsIgnoreCase, negated: true},
+	"StringLike":                {compare: policyEvaluationStringLike},
+	"StringNotLike":             {compare: policyEvaluationStringLike, negated: true},
+	"NumericEquals":             {compare: policyEvaluationCompare(policyEvaluationParseNumber, func(p, r float64) bool { return r == p })},
+	"NumericNotEquals":          {compare: policyEvaluationCompare(policyEvaluationParseNumber, func(p, r float64) bool { return r == p }), negated: true},
+	"NumericLessThan":           {compare: policyEvaluationCompare(policyEvaluationParseNumber, func(p, r float64) bool { return r < p })},
+	"NumericLessThanEquals":     {compare: policyEvaluationCompare(policyEvaluationParseNumber, func(p, r float64) bool { return r <= p })},
+	"NumericGreaterThan":        {compare: policyEvaluationCompare(policyEvaluationParseNumber, func(p, r float64) bool { return r > p })},
+	"NumericGreaterThanEquals":  {compare: policyEvaluationCompare(policyEvaluationParseNumber, func(p, r float64) bool { return r >= p })},
+	"DateEquals":                {compare: policyEvaluationCompare(policyEvaluationParseDate, func(p, r time.Time) bool { return r.Equal(p) })},
+	"DateNotEquals":             {compare: policyEvaluationCompare(policyEvaluationParseDate, func(p, r time.Time) bool { return r.Equal(p) }), negated: true},
+	"DateLessThan":              {compare: policyEvaluationCompare(policyEvaluationParseDate, func(p, r time.Time) bool { return r.Before(p) })},
+	"DateLessThanEquals":        {compare: policyEvaluationCompare(policyEvaluationParseDate, func(p, r time.Time) bool { return !r.After(p) })},
+	"DateGreaterThan":           {compare: policyEvaluationCompare(policyEvaluationParseDate, func(p, r time.Time) bool { return r.After(p) })},
+	"DateGreaterThanEquals":     {compare: policyEvaluationCompare(policyEvaluationParseDate, func(p, r time.Time) bool { return !r.Before(p) })},
+	"Bool":                      {compare: policyEvaluationStringEqualsIgnoreCase},
+	"BinaryEquals":              {compare: policyEvaluationStringEquals},
+	"IpAddress":                 {compare: policyEvaluationIPAddress},
+	"NotIpAddress":              {compare: policyEvaluationIPAddress, negated: true},
+	"ArnEquals":                 {compare: policyEvaluationStringLike},
+	"ArnLike":                   {compare: policyEvaluationStringLike},
+	"ArnNotEquals":              {compare: policyEvaluationStringLike, negated: true},
+	"ArnNotLike":                {compare: policyEvaluationStringLike, negated: true},
+}
+
+func (e *policyEvaluator) conditionMatches(condition IAMPolicyStatementCondition, result *policyEvaluationResult) (bool, error) {
+	name := condition.Test
+
+	var forAllValues, forAnyValue, ifExists bool
+	if v, ok := strings.CutPrefix(name, "ForAllValues:"); ok {
+		name, forAllValues = v, true
+	} else if v, ok := strings.CutPrefix(name, "ForAnyValue:"); ok {
+		name, forAnyValue = v, true
+	}
+	if v, ok := strings.CutSuffix(name, "IfExists"); ok {
+		name, ifExists = v, true
+	}
+
+	policyValues := policyEvaluationStrings(condition.Values)
+	requestValues, present := e.context[strings.ToLower(condition.Variable)]
+
+	if name == "Null" {
+		if len(policyValues) != 1 {
+			return false, fmt.Errorf("condition operator %s requires a single value", condition.Test)
+		}
+
+		absent, err := strconv.ParseBool(policyValues[0])
+		if err != nil {
+			return false, fmt.Errorf("condition operator %s: %w", condition.Test, err)
+		}
+
+		return absent == !present, nil
+	}
+
+	operator, ok := policyEvaluationConditionOperators[name]
+	if !ok {
+		return false, fmt.Errorf("unsupported condition operator: %s", condition.Test)
+	}
+
+	if !present {
+		if !ifExists {
+			result.MissingContextKeys = tfslices.AppendUnique(result.MissingContextKeys, condition.Variable)
+		}
+
+		// A missing key matches a negated operator, unless any request value must match.
+		return ifExists || forAllValues || (operator.negated && !forAnyValue), nil
+	}
+
+	// Condition values are ORed.
+	matchesAny := func(requestValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			policyValue, ok := e.resolveVariables(policyValue)
+			if !ok {
+				continue
+			}
+
+			ok, err := operator.compare(policyValue, requestValue)
+			if err != nil {
+				return false, fmt.Errorf("condition operator %s: %w", condition.Test, err)
+			}
+
+			if ok {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	switch {
+	case forAllValues:
+		for _, requestValue := range requestValues {
+			ok, err := matchesAny(requestValue)
+			if err != nil {
+				return false, err
+			}
+
+			if ok == operator.negated {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	case forAnyValue:
+		for _, requestValue := range requestValues {
+			ok, err := matchesAny(requestValue)
+			if err != nil {
+				return false, err
+			}
+
+			if ok != operator.negated {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	default:
+		for _, requestValue := range requestValues {
+			ok, err := matchesAny(requestValue)
+			if err != nil {
+				return false, err
+			}
+
+			if ok {
+				return !operator.negated, nil
+			}
+		}
+
+		return operator.negated, nil
+	}
+}
+
+// resolveVariables replaces policy variables, such as ${aws:username}, with values from the request context.
+// It returns false if a variable has no single value in the request context and no default value.
+func (e *policyEvaluator) resolveVariables(s string) (string, bool) {
+	var sb strings.Builder
+
+	for {
+		start := strings.Index(s, "${")
+		if start == -1 {
+			break
+		}
+
+		end := strings.IndexByte(s[start:], '}')
+		if end == -1 {
+			break
+		}
+		end += start
+
+		sb.WriteString(s[:start])
+		variable := s[start+2 : end]
+		s = s[end+1:]
+
+		switch variable {
+		case "*":
+			sb.WriteRune(policyEvaluationLiteralAsterisk)
+		case "?":
+			sb.WriteRune(policyEvaluationLiteralQuestion)
+		case "$":
+			sb.WriteByte('$')
+		default:
+			key, defaultValue, hasDefault := strings.Cut(variable, ",")
+
+			if v := e.context[strings.ToLower(strings.TrimSpace(key))]; len(v) == 1 {
+				sb.WriteString(v[0])
+			} else if hasDefault {
+				sb.WriteString(strings.Trim(strings.TrimSpace(defaultValue), "'"))
+			} else {
+				return "", false
+			}
+		}
+	}
+
+	sb.WriteString(s)
+
+	return sb.String(), true
+}
+
+// policyEvaluationWildcardMatch reports whether value matches pattern, in which
+// '*' matches any sequence of characters and '?' matches any single character.
+func policyEvaluationWildcardMatch(pattern, value string, ignoreCase bool) bool {
+	if ignoreCase {
+		pattern, value = strings.ToLower(pattern), strings.ToLower(value)
+	}
+
+	p, v := []rune(pattern), []rune(value)
+	pi, vi, star, mark := 0, 0, -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || policyEvaluationRuneEqual(p[pi], v[vi])):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, vi
+			pi++
+		case star != -1:
+			mark++
+			pi, vi = star+1, mark
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
+
+func policyEvaluationRuneEqual(p, v rune) bool {
+	switch p {
+	case policyEvaluationLiteralAsterisk:
+		return v == '*'
+	case policyEvaluationLiteralQuestion:
+		return v == '?'
+	default:
+		return p == v
+	}
+}
+
+func policyEvaluationUnescape(s string) string {
+	return strings.NewReplacer(string(policyEvaluationLiteralAsterisk), "*", string(policyEvaluationLiteralQuestion), "?").Replace(s)
+}
+
+func policyEvaluationStringEquals(policyValue, requestValue string) (bool, error) {
+	return policyEvaluationUnescape(policyValue) == requestValue, nil
+}
+
+func policyEvaluationStringEqualsIgnoreCase(policyValue, requestValue string) (bool, error) {
+	return strings.EqualFold(policyEvaluationUnescape(policyValue), requestValue), nil
+}
+
+func policyEvaluationStringLike(policyValue, requestValue string) (bool, error) {
+	return policyEvaluationWildcardMatch(policyValue, requestValue, false), nil
+}
+
+func policyEvaluationCompare[T any](parse func(string) (T, error), compare func(policyValue, requestValue T) bool) policyEvaluationComparator {
+	return func(policyValue, requestValue string) (bool, error) {
+		p, err := parse(policyValue)
+		if err != nil {
+			return false, err
+		}
+
+		r, err := parse(requestValue)
+		if err != nil {
+			return false, err
+		}
+
+		return compare(p, r), nil
+	}
+}
+
+func policyEvaluationParseNumber(s string) (float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing number (%s): %w", s, err)
+	}
+
+	return v, nil
+}
+
+func policyEvaluationParseDate(s string) (time.Time, error) {
+	// Dates are specified either in an ISO 8601 format or as an epoch time in seconds.
+	if regexache.MustCompile(`^\d+$`).MatchString(s) {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing date (%s): %w", s, err)
+		}
+
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", time.DateOnly} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("parsing date (%s): unsupported format", s)
+}
+
+func policyEvaluationIPAddress(policyValue, requestValue string) (bool, error) {
+	ip := net.ParseIP(requestValue)
+	if ip == nil {
+		return false, fmt.Errorf("parsing IP address (%s)", requestValue)
+	}
+
+	if !strings.Contains(policyValue, "/") {
+		v := net.ParseIP(policyValue)
+		if v == nil {
+			return false, fmt.Errorf("parsing IP address (%s)", policyValue)
+		}
+
+		return v.Equal(ip), nil
+	}
+
+	_, network, err := net.ParseCIDR(policyValue)
+	if err != nil {
+		return false, fmt.Errorf("parsing CIDR block (%s): %w", policyValue, err)
+	}
+
+	return network.Contains(ip), nil
+}
+
+// policyEvaluationStrings returns the string values of a policy element, which is either a string or a list of strings.
+func policyEvaluationStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+// unmarshalPolicyEvaluationDocuments parses JSON policy documents. Empty documents are skipped.
+func unmarshalPolicyEvaluationDocuments(policyType string, policies []string) ([]*IAMPolicyDoc, error) {
+	docs := make([]*IAMPolicyDoc, len(policies))
+
+	for i, policy := range policies {
+		if policy == "" {
+			continue
+		}
+
+		doc, err := unmarshalPolicyEvaluationDocument(policy)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s policy %d: %w", policyType, i, err)
+		}
+
+		docs[i] = doc
+	}
+
+	return docs, nil
+}
+
+func unmarshalPolicyEvaluationDocument(policy string) (*IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	statement := bytes.TrimSpace(raw.Statement)
+	if len(statement) > 0 {
+		var err error
+		if statement, err = stringifyPolicyEvaluationConditionValues(statement); err != nil {
+			return nil, err
+		}
+	}
+
+	// A policy's Statement element is either a single statement or a list of statements.
+	switch {
+	case len(statement) == 0:
+	case statement[0] == '{':
+		v := &IAMPolicyStatement{}
+		if err := json.Unmarshal(statement, v); err != nil {
+			return nil, err
+		}
+		doc.Statements = []*IAMPolicyStatement{v}
+	default:
+		if err := json.Unmarshal(statement, &doc.Statements); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+// stringifyPolicyEvaluationConditionValues returns the specified Statement element with any boolean or numeric
+// condition values, e.g. {"NumericLessThan":{"s3:max-keys":10}}, converted to strings.
+func stringifyPolicyEvaluationConditionValues(statement []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(statement))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	statements, ok := v.([]any)
+	if !ok {
+		statements = []any{v}
+	}
+
+	stringify := func(v any) any {
+		switch v := v.(type) {
+		case bool:
+			return strconv.FormatBool(v)
+		case json.Number:
+			return v.String()
+		default:
+			return v
+		}
+	}
+
+	for _, statement := range statements {
+		statement, ok := statement.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		conditions, ok := statement["Condition"].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, condition := range conditions {
+			condition, ok := condition.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			for key, values := range condition {
+				if values, ok := values.([]any); ok {
+					for i, value := range values {
+						values[i] = stringify(value)
+					}
+					continue
+				}
+
+				condition[key] = stringify(values)
+			}
+		}
+	}
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,496 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyEvaluator(t *testing.T) {
+	t.Parallel()
+
+	const (
+		callerARN = "arn:aws:iam::123456789012:role/example" //lintignore:AWSAT005
+		bucketARN = "arn:aws:s3:::example"                   //lintignore:AWSAT005
+		objectARN = "arn:aws:s3:::example/home/alice/file"   //lintignore:AWSAT005
+	)
+
+	testCases := map[string]struct {
+		input              policyEvaluationInput
+		action             string
+		resource           string
+		wantDecision       awstypes.PolicyEvaluationDecisionType
+		wantPolicyType     string
+		wantSid            string
+		wantMissingContext []string
+		wantMatched        []policyEvaluationStatement
+		wantErr            bool
+	}{
+		"no policies": {
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+		},
+		"identity allow": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:Get*","Resource":"arn:aws:s3:::example/*"}]}`}, //lintignore:AWSAT005
+			},
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Read",
+		},
+		"single statement object": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}}`},
+			},
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Read",
+		},
+		"action case insensitive": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Read","Effect":"Allow","Action":"S3:getobject","Resource":"*"}]}`},
+			},
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Read",
+		},
+		"resource case sensitive": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::EXAMPLE/*"}]}`}, //lintignore:AWSAT005
+			},
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+		},
+		"explicit deny": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{
+					`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`,
+					`{"Statement":[{"Sid":"NoDelete","Effect":"Deny","Action":"s3:Delete*","Resource":"*"}]}`,
+				},
+			},
+			action:         "s3:DeleteObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeExplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "NoDelete",
+		},
+		"NotAction": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"NotIAM","Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`},
+			},
+			action:         "iam:CreateUser",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+		},
+		"NotResource": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{
+					`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+					`{"Statement":[{"Sid":"OnlyExample","Effect":"Deny","Action":"s3:*","NotResource":["arn:aws:s3:::example","arn:aws:s3:::example/*"]}]}`, //lintignore:AWSAT005
+				},
+			},
+			action:         "s3:GetObject",
+			resource:       "arn:aws:s3:::other/file", //lintignore:AWSAT005
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeExplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "OnlyExample",
+		},
+		"permissions boundary": {
+			input: policyEvaluationInput{
+				IdentityPolicies:            []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				PermissionsBoundaryPolicies: []string{`{"Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+			},
+			action:         "ec2:RunInstances",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypePermissionsBoundary,
+		},
+		"service control policy": {
+			input: policyEvaluationInput{
+				IdentityPolicies:       []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				ServiceControlPolicies: [][]string{{`{"Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`}},
+			},
+			action:         "ec2:RunInstances",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeServiceControl,
+		},
+		"service control policy levels not allowed": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				ServiceControlPolicies: [][]string{
+					{`{"Statement":[{"Sid":"FullAWSAccess","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+					{`{"Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+				},
+			},
+			action:         "ec2:RunInstances",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeServiceControl,
+		},
+		"service control policy levels allowed": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				ServiceControlPolicies: [][]string{
+					{`{"Statement":[{"Sid":"FullAWSAccess","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+					{`{"Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+				},
+			},
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "All",
+		},
+		"service control policy level without policies": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				ServiceControlPolicies: [][]string{
+					{`{"Statement":[{"Sid":"FullAWSAccess","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+					{},
+				},
+			},
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeServiceControl,
+		},
+		"service control policy level deny": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				ServiceControlPolicies: [][]string{
+					{`{"Statement":[{"Sid":"FullAWSAccess","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+					{
+						`{"Statement":[{"Sid":"FullAWSAccess","Effect":"Allow","Action":"*","Resource":"*"}]}`,
+						`{"Statement":[{"Sid":"NoLeave","Effect":"Deny","Action":"organizations:LeaveOrganization","Resource":"*"}]}`,
+					},
+				},
+			},
+			action:         "organizations:LeaveOrganization",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeExplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeServiceControl,
+			wantSid:        "NoLeave",
+			wantMatched: []policyEvaluationStatement{
+				{Effect: policyEvaluationEffectAllow, PolicyIndex: 0, PolicyType: policyEvaluationPolicyTypeServiceControl, Sid: "FullAWSAccess"},
+				{Effect: policyEvaluationEffectAllow, PolicyIndex: 1, PolicyType: policyEvaluationPolicyTypeServiceControl, Sid: "FullAWSAccess"},
+				{Effect: policyEvaluationEffectDeny, PolicyIndex: 2, PolicyType: policyEvaluationPolicyTypeServiceControl, Sid: "NoLeave"},
+				{Effect: policyEvaluationEffectAllow, PolicyIndex: 0, PolicyType: policyEvaluationPolicyTypeIdentity, Sid: "All"},
+			},
+		},
+		"resource policy allow": {
+			input: policyEvaluationInput{
+				CallerARN:      callerARN,
+				ResourcePolicy: `{"Statement":[{"Sid":"Account","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:ListBucket","Resource":"arn:aws:s3:::example"}]}`, //lintignore:AWSAT005
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeResource,
+			wantSid:        "Account",
+		},
+		"resource policy other principal": {
+			input: policyEvaluationInput{
+				CallerARN:      callerARN,
+				ResourcePolicy: `{"Statement":[{"Sid":"Other","Effect":"Allow","Principal":{"AWS":"210987654321"},"Action":"s3:ListBucket","Resource":"arn:aws:s3:::example"}]}`, //lintignore:AWSAT005
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+		},
+		"resource policy deny": {
+			input: policyEvaluationInput{
+				CallerARN:        callerARN,
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				ResourcePolicy:   `{"Statement":[{"Sid":"TLS","Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::example","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`, //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:SecureTransport": {"false"},
+				},
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeExplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeResource,
+			wantSid:        "TLS",
+		},
+		"condition missing key": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Tagged","Effect":"Allow","Action":"ec2:*","Resource":"*","Condition":{"StringEquals":{"aws:ResourceTag/Team":"a"}}}]}`},
+			},
+			action:             "ec2:StopInstances",
+			resource:           "*",
+			wantDecision:       awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType:     policyEvaluationPolicyTypeIdentity,
+			wantMissingContext: []string{"aws:ResourceTag/Team"},
+		},
+		"condition negated missing key": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"NotTeamB","Effect":"Allow","Action":"ec2:*","Resource":"*","Condition":{"StringNotEquals":{"aws:ResourceTag/Team":"b"}}}]}`},
+			},
+			action:             "ec2:StopInstances",
+			resource:           "*",
+			wantDecision:       awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType:     policyEvaluationPolicyTypeIdentity,
+			wantSid:            "NotTeamB",
+			wantMissingContext: []string{"aws:ResourceTag/Team"},
+		},
+		"condition IfExists": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Type","Effect":"Allow","Action":"ec2:RunInstances","Resource":"*","Condition":{"StringLikeIfExists":{"ec2:InstanceType":"t3.*"}}}]}`},
+			},
+			action:         "ec2:RunInstances",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Type",
+		},
+		"condition key case insensitive": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Region","Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":["us-west-2","us-east-1"]}}}]}`}, //lintignore:AWSAT003
+				Context: map[string][]string{
+					"AWS:REQUESTEDREGION": {"us-east-1"}, //lintignore:AWSAT003
+				},
+			},
+			action:         "ec2:RunInstances",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Region",
+		},
+		"condition numeric": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"MaxKeys","Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":10}}}]}`},
+				Context: map[string][]string{
+					"s3:max-keys": {"20"},
+				},
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+		},
+		"condition boolean list": {
+			input: policyEvaluationInput{
+				CallerARN:        callerARN,
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				ResourcePolicy:   `{"Statement":{"Sid":"TLS","Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::example","Condition":{"Bool":{"aws:SecureTransport":[false]}}}}`, //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:SecureTransport": {"false"},
+				},
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeExplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeResource,
+			wantSid:        "TLS",
+		},
+		"condition date": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Until","Effect":"Allow","Action":"*","Resource":"*","Condition":{"DateLessThan":{"aws:CurrentTime":"2030-01-01T00:00:00Z"}}}]}`},
+				Context: map[string][]string{
+					"aws:CurrentTime": {"2026-06-01T12:00:00Z"},
+				},
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Until",
+		},
+		"condition IP address": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{
+					`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`,
+					`{"Statement":[{"Sid":"Network","Effect":"Deny","Action":"*","Resource":"*","Condition":{"NotIpAddress":{"aws:SourceIp":["192.0.2.0/24","203.0.113.10"]}}}]}`,
+				},
+				Context: map[string][]string{
+					"aws:SourceIp": {"198.51.100.1"},
+				},
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeExplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Network",
+		},
+		"condition ForAllValues": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Keys","Effect":"Allow","Action":"ec2:CreateTags","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["Name","Team"]}}}]}`},
+				Context: map[string][]string{
+					"aws:TagKeys": {"Name", "Owner"},
+				},
+			},
+			action:         "ec2:CreateTags",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+		},
+		"condition ForAnyValue": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Keys","Effect":"Allow","Action":"ec2:CreateTags","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":["Name","Team"]}}}]}`},
+				Context: map[string][]string{
+					"aws:TagKeys": {"Name", "Owner"},
+				},
+			},
+			action:         "ec2:CreateTags",
+			resource:       "*",
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Keys",
+		},
+		"condition Null": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"MFA","Effect":"Deny","Action":"*","Resource":"*","Condition":{"Null":{"aws:MultiFactorAuthAge":"true"}}}]}`},
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeExplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "MFA",
+		},
+		"condition ArnLike": {
+			input: policyEvaluationInput{
+				CallerARN:        callerARN,
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Roles","Effect":"Allow","Action":"*","Resource":"*","Condition":{"ArnLike":{"aws:PrincipalArn":"arn:aws:iam::*:role/ex*"}}}]}`}, //lintignore:AWSAT005
+			},
+			action:         "s3:ListBucket",
+			resource:       bucketARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Roles",
+		},
+		"policy variable": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Home","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/home/${aws:username}/*"}]}`}, //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:username": {"alice"},
+				},
+			},
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeAllowed,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+			wantSid:        "Home",
+		},
+		"policy variable missing": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Home","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/home/${aws:username}/*"}]}`}, //lintignore:AWSAT005
+			},
+			action:         "s3:GetObject",
+			resource:       objectARN,
+			wantDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+			wantPolicyType: policyEvaluationPolicyTypeIdentity,
+		},
+		"unsupported condition operator": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement":[{"Sid":"Bad","Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringSimilar":{"aws:username":"alice"}}}]}`},
+			},
+			action:   "s3:GetObject",
+			resource: objectARN,
+			wantErr:  true,
+		},
+		"invalid JSON": {
+			input: policyEvaluationInput{
+				IdentityPolicies: []string{`{`},
+			},
+			action:   "s3:GetObject",
+			resource: objectARN,
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			evaluator, err := newPolicyEvaluator(&testCase.input)
+
+			var result *policyEvaluationResult
+			if err == nil {
+				result, err = evaluator.Evaluate(testCase.action, testCase.resource)
+			}
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, wantErr = %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := result.Decision, testCase.wantDecision; got != want {
+				t.Errorf("decision = %s, want %s", got, want)
+			}
+
+			if got, want := result.DecidingPolicyType, testCase.wantPolicyType; got != want {
+				t.Errorf("deciding policy type = %s, want %s", got, want)
+			}
+
+			var sid string
+			if result.DecidingStatement != nil {
+				sid = result.DecidingStatement.Sid
+			}
+			if got, want := sid, testCase.wantSid; got != want {
+				t.Errorf("deciding statement Sid = %q, want %q", got, want)
+			}
+
+			if diff := cmp.Diff(result.MissingContextKeys, testCase.wantMissingContext); diff != "" {
+				t.Errorf("unexpected missing context keys difference: %s", diff)
+			}
+
+			if testCase.wantMatched != nil {
+				if diff := cmp.Diff(result.MatchedStatements, testCase.wantMatched); diff != "" {
+					t.Errorf("unexpected matched statements difference: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluationWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern    string
+		value      string
+		ignoreCase bool
+		want       bool
+	}{
+		{pattern: "*", value: "", want: true},
+		{pattern: "*", value: "anything", want: true},
+		{pattern: "s3:Get*", value: "s3:GetObject", want: true},
+		{pattern: "s3:Get*", value: "s3:PutObject", want: false},
+		{pattern: "s3:*Object", value: "s3:GetObject", want: true},
+		{pattern: "s3:?etObject", value: "s3:GetObject", want: true},
+		{pattern: "s3:?etObject", value: "s3:etObject", want: false},
+		{pattern: "a*b*c", value: "aXbYbZc", want: true},
+		{pattern: "a*b*c", value: "aXbYbZ", want: false},
+		{pattern: "S3:GETOBJECT", value: "s3:GetObject", want: false},
+		{pattern: "S3:GETOBJECT", value: "s3:GetObject", ignoreCase: true, want: true},
+		{pattern: "file" + string(policyEvaluationLiteralAsterisk), value: "file*", want: true},
+		{pattern: "file" + string(policyEvaluationLiteralAsterisk), value: "file1", want: false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := policyEvaluationWildcardMatch(testCase.pattern, testCase.value, testCase.ignoreCase), testCase.want; got != want {
+			t.Errorf("policyEvaluationWildcardMatch(%q, %q, %t) = %t, want %t", testCase.pattern, testCase.value, testCase.ignoreCase, got, want)
+		}
+	}
+}
@@ -64,6 +64,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_policy_document",
 			Name:     "Policy Document",
 		},
+		{
+			Factory:  dataSourcePolicyEvaluation,
+			TypeName: "aws_iam_policy_evaluation",
+			Name:     "Policy Evaluation",
+		},
 		{
 			Factory:  dataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
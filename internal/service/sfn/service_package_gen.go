@@ -37,6 +37,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceStateMachine,
 			TypeName: "aws_sfn_state_machine",
 		},
+		{
+			Factory:  dataSourceStateMachineDefinitionDocument,
+			TypeName: "aws_sfn_state_machine_definition_document",
+			Name:     "State Machine Definition Document",
+		},
 		{
 			Factory:  DataSourceStateMachineVersions,
 			TypeName: "aws_sfn_state_machine_versions",
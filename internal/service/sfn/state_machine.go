@@ -13,7 +13,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -161,7 +163,10 @@ func ResourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			stateMachineDefinitionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -350,6 +355,28 @@ func resourceStateMachineDelete(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// stateMachineDefinitionCustomizeDiff validates a new or changed definition at plan time.
+// CustomizeDiff cannot return warnings so any are only logged.
+func stateMachineDefinitionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("definition") || !d.NewValueKnown("definition") {
+		return nil
+	}
+
+	warnings, err := validateStateMachineDefinition(d.Get("definition").(string))
+
+	for _, warning := range warnings {
+		tflog.Warn(ctx, "Step Functions State Machine definition", map[string]any{
+			"warning": warning,
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid definition: %w", err)
+	}
+
+	return nil
+}
+
 func FindStateMachineByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineOutput, error) {
 	input := &sfn.DescribeStateMachineInput{
 		StateMachineArn: aws.String(arn),
@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_sfn_state_machine_definition_document", name="State Machine Definition Document")
+func dataSourceStateMachineDefinitionDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStateMachineDefinitionDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			errorEqualsSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				}
+			}
+			jsonSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsJSON,
+				}
+			}
+
+			return map[string]*schema.Schema{
+				"comment": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"json": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"start_at": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"state": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"branches": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringIsJSON,
+								},
+							},
+							"catch": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"error_equals": errorEqualsSchema(),
+										"next": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"result_path": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"cause": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"choice": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"next": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"rule": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringIsJSON,
+										},
+										"test": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"value": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"variable": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"comment": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"default": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"end": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"error": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"heartbeat_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"input_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"item_processor": jsonSchema(),
+							"item_selector":  jsonSchema(),
+							"items_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"max_concurrency": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 80),
+							},
+							"next": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"output_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"parameters": jsonSchema(),
+							"processor_config": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"execution_type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"EXPRESS", "STANDARD"}, false),
+										},
+										"mode": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"DISTRIBUTED", "INLINE"}, false),
+										},
+									},
+								},
+							},
+							"resource": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"result": jsonSchema(),
+							"result_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"result_selector": jsonSchema(),
+							"retry": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"backoff_rate": {
+											Type:         schema.TypeFloat,
+											Optional:     true,
+											ValidateFunc: validation.FloatAtLeast(1.0),
+										},
+										"error_equals": errorEqualsSchema(),
+										"interval_seconds": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+										"jitter_strategy": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"FULL", "NONE"}, false),
+										},
+										"max_attempts": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      3,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+										"max_delay_seconds": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+									},
+								},
+							},
+							"seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"seconds_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"timeout_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"timestamp": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+							},
+							"timestamp_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(stateMachineStateType_Values(), false),
+							},
+						},
+					},
+				},
+				"timeout_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceStateMachineDefinitionDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	definition := &stateMachineDefinition{
+		Comment:        d.Get("comment").(string),
+		StartAt:        d.Get("start_at").(string),
+		States:         make(map[string]*stateMachineState),
+		TimeoutSeconds: d.Get("timeout_seconds").(int),
+		Version:        d.Get("version").(string),
+	}
+
+	for _, tfMapRaw := range d.Get("state").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		if _, ok := definition.States[name]; ok {
+			return sdkdiag.AppendErrorf(diags, "writing Step Functions State Machine Definition Document: duplicate state name (%s)", name)
+		}
+
+		state, err := expandStateMachineState(tfMap)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing Step Functions State Machine Definition Document: state (%s): %s", name, err)
+		}
+
+		definition.States[name] = state
+	}
+
+	jsonDoc, err := json.MarshalIndent(definition, "", "  ")
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing Step Functions State Machine Definition Document: formatting JSON: %s", err)
+	}
+	jsonString := string(jsonDoc)
+
+	warnings, err := validateStateMachineDefinition(jsonString)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Step Functions State Machine Definition Document: invalid definition: %s", err)
+	}
+
+	for _, warning := range warnings {
+		diags = sdkdiag.AppendWarningf(diags, "writing Step Functions State Machine Definition Document: %s", warning)
+	}
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+func expandStateMachineState(tfMap map[string]interface{}) (*stateMachineState, error) {
+	state := &stateMachineState{
+		Cause:            tfMap["cause"].(string),
+		Comment:          tfMap["comment"].(string),
+		Default:          tfMap["default"].(string),
+		End:              tfMap["end"].(bool),
+		Error:            tfMap["error"].(string),
+		HeartbeatSeconds: tfMap["heartbeat_seconds"].(int),
+		InputPath:        tfMap["input_path"].(string),
+		ItemsPath:        tfMap["items_path"].(string),
+		MaxConcurrency:   tfMap["max_concurrency"].(int),
+		Next:             tfMap["next"].(string),
+		OutputPath:       tfMap["output_path"].(string),
+		Resource:         tfMap["resource"].(string),
+		ResultPath:       tfMap["result_path"].(string),
+		SecondsPath:      tfMap["seconds_path"].(string),
+		TimeoutSeconds:   tfMap["timeout_seconds"].(int),
+		Timestamp:        tfMap["timestamp"].(string),
+		TimestampPath:    tfMap["timestamp_path"].(string),
+		Type:             tfMap["type"].(string),
+	}
+
+	// A Wait state with no other wait duration waits for the specified number of seconds, which may be zero.
+	if v := tfMap["seconds"].(int); v > 0 || (state.Type == stateMachineStateTypeWait && state.SecondsPath == "" && state.Timestamp == "" && state.TimestampPath == "") {
+		state.Seconds = aws.Int(v)
+	}
+
+	if v, ok := tfMap["item_selector"].(string); ok && v != "" {
+		state.ItemSelector = json.RawMessage(v)
+	}
+
+	if v, ok := tfMap["parameters"].(string); ok && v != "" {
+		state.Parameters = json.RawMessage(v)
+	}
+
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		state.Result = json.RawMessage(v)
+	}
+
+	if v, ok := tfMap["result_selector"].(string); ok && v != "" {
+		state.ResultSelector = json.RawMessage(v)
+	}
+
+	for _, v := range tfMap["branches"].([]interface{}) {
+		if v, ok := v.(string); ok && v != "" {
+			state.Branches = append(state.Branches, json.RawMessage(v))
+		}
+	}
+
+	if v, ok := tfMap["item_processor"].(string); ok && v != "" {
+		if err := json.Unmarshal([]byte(v), &state.ItemProcessor); err != nil {
+			return nil, fmt.Errorf("parsing item_processor: %w", err)
+		}
+
+		if v, ok := tfMap["processor_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			state.ItemProcessor["ProcessorConfig"] = &stateMachineProcessorConfig{
+				ExecutionType: tfMap["execution_type"].(string),
+				Mode:          tfMap["mode"].(string),
+			}
+		}
+	}
+
+	for _, v := range tfMap["choice"].([]interface{}) {
+		if v, ok := v.(map[string]interface{}); ok {
+			rule, err := expandStateMachineChoiceRule(v)
+			if err != nil {
+				return nil, err
+			}
+
+			state.Choices = append(state.Choices, rule)
+		}
+	}
+
+	for _, v := range tfMap["retry"].([]interface{}) {
+		if v, ok := v.(map[string]interface{}); ok {
+			state.Retry = append(state.Retry, &stateMachineRetrier{
+				BackoffRate:     v["backoff_rate"].(float64),
+				ErrorEquals:     flex.ExpandStringValueList(v["error_equals"].([]interface{})),
+				IntervalSeconds: v["interval_seconds"].(int),
+				JitterStrategy:  v["jitter_strategy"].(string),
+				MaxAttempts:     v["max_attempts"].(int),
+				MaxDelaySeconds: v["max_delay_seconds"].(int),
+			})
+		}
+	}
+
+	for _, v := range tfMap["catch"].([]interface{}) {
+		if v, ok := v.(map[string]interface{}); ok {
+			state.Catch = append(state.Catch, &stateMachineCatcher{
+				ErrorEquals: flex.ExpandStringValueList(v["error_equals"].([]interface{})),
+				Next:        v["next"].(string),
+				ResultPath:  v["result_path"].(string),
+			})
+		}
+	}
+
+	return state, nil
+}
+
+// expandStateMachineChoiceRule returns a Choice state's choice rule.
+// The rule is either a JSON-encoded Boolean expression, such as {"And": [...]}, or a single comparison.
+func expandStateMachineChoiceRule(tfMap map[string]interface{}) (map[string]interface{}, error) {
+	rule := make(map[string]interface{})
+
+	if v, ok := tfMap["rule"].(string); ok && v != "" {
+		if err := json.Unmarshal([]byte(v), &rule); err != nil {
+			return nil, fmt.Errorf("parsing choice rule: %w", err)
+		}
+	} else {
+		test, variable := tfMap["test"].(string), tfMap["variable"].(string)
+		if test == "" || variable == "" {
+			return nil, fmt.Errorf("choice (%s): one of rule or both test and variable must be set", tfMap["next"].(string))
+		}
+
+		value, err := expandStateMachineChoiceRuleValue(test, tfMap["value"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("choice (%s): %w", tfMap["next"].(string), err)
+		}
+
+		rule["Variable"] = variable
+		rule[test] = value
+	}
+
+	rule["Next"] = tfMap["next"].(string)
+
+	return rule, nil
+}
+
+// expandStateMachineChoiceRuleValue converts a comparison value to the type expected by the comparison operator.
+func expandStateMachineChoiceRuleValue(test, value string) (interface{}, error) {
+	switch {
+	case strings.HasSuffix(test, "Path"):
+		return value, nil
+	case strings.HasPrefix(test, "Numeric"):
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: parsing value (%s): %w", test, value, err)
+		}
+		return v, nil
+	case test == "BooleanEquals", strings.HasPrefix(test, "Is"):
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: parsing value (%s): %w", test, value, err)
+		}
+		return v, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccStateMachineDefinitionDocumentDataSourceConfig_basic_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_parallelAndMap(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_parallelAndMap,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccStateMachineDefinitionDocumentDataSourceConfig_parallelAndMap_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_waitZeroSeconds(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_waitZeroSeconds,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccStateMachineDefinitionDocumentDataSourceConfig_waitZeroSeconds_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_duplicateName(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_duplicateName,
+				ExpectError: regexache.MustCompile(`duplicate state name \(Done\)`),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`/States/Start: Next state \(Missing\) not found`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  comment  = "Example"
+  start_at = "Check"
+
+  state {
+    name    = "Check"
+    type    = "Choice"
+    default = "Wait"
+
+    choice {
+      next     = "Work"
+      test     = "NumericGreaterThan"
+      variable = "$.count"
+      value    = "10"
+    }
+
+    choice {
+      next = "Done"
+      rule = jsonencode({
+        Variable      = "$.skip"
+        BooleanEquals = true
+      })
+    }
+  }
+
+  state {
+    name = "Wait"
+    type = "Wait"
+    next = "Work"
+
+    seconds = 5
+  }
+
+  state {
+    name     = "Work"
+    type     = "Task"
+    resource = "arn:aws:states:::lambda:invoke"
+    next     = "Done"
+
+    parameters = jsonencode({
+      FunctionName = "example"
+      "Payload.$"  = "$"
+    })
+    result_path = "$.result"
+
+    retry {
+      error_equals     = ["States.TaskFailed"]
+      interval_seconds = 2
+      max_attempts     = 4
+      backoff_rate     = 1.5
+    }
+
+    catch {
+      error_equals = ["States.ALL"]
+      next         = "Failed"
+    }
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+
+  state {
+    name  = "Failed"
+    type  = "Fail"
+    error = "WorkFailed"
+    cause = "The work task failed."
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_basic_ExpectedJSON = `{
+  "Comment": "Example",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {
+          "Variable": "$.count",
+          "NumericGreaterThan": 10,
+          "Next": "Work"
+        },
+        {
+          "Variable": "$.skip",
+          "BooleanEquals": true,
+          "Next": "Done"
+        }
+      ],
+      "Default": "Wait"
+    },
+    "Done": {
+      "Type": "Succeed"
+    },
+    "Failed": {
+      "Type": "Fail",
+      "Cause": "The work task failed.",
+      "Error": "WorkFailed"
+    },
+    "Wait": {
+      "Type": "Wait",
+      "Seconds": 5,
+      "Next": "Work"
+    },
+    "Work": {
+      "Type": "Task",
+      "Parameters": {
+        "FunctionName": "example",
+        "Payload.$": "$"
+      },
+      "ResultPath": "$.result",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Retry": [
+        {
+          "ErrorEquals": ["States.TaskFailed"],
+          "IntervalSeconds": 2,
+          "MaxAttempts": 4,
+          "BackoffRate": 1.5
+        }
+      ],
+      "Catch": [
+        {
+          "ErrorEquals": ["States.ALL"],
+          "Next": "Failed"
+        }
+      ],
+      "Next": "Done"
+    }
+  }
+}`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_parallelAndMap = `
+data "aws_sfn_state_machine_definition_document" "branch" {
+  start_at = "Hello"
+
+  state {
+    name   = "Hello"
+    type   = "Pass"
+    result = jsonencode("Hello")
+    end    = true
+  }
+}
+
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at        = "Fan"
+  timeout_seconds = 300
+
+  state {
+    name = "Fan"
+    type = "Parallel"
+    next = "Each"
+
+    branches = [
+      data.aws_sfn_state_machine_definition_document.branch.json,
+      jsonencode({
+        StartAt = "World"
+        States = {
+          World = {
+            Type = "Succeed"
+          }
+        }
+      }),
+    ]
+  }
+
+  state {
+    name = "Each"
+    type = "Map"
+    end  = true
+
+    items_path      = "$.items"
+    max_concurrency = 2
+
+    item_processor = data.aws_sfn_state_machine_definition_document.branch.json
+
+    processor_config {
+      mode = "INLINE"
+    }
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_parallelAndMap_ExpectedJSON = `{
+  "StartAt": "Fan",
+  "States": {
+    "Each": {
+      "Type": "Map",
+      "ItemProcessor": {
+        "ProcessorConfig": {
+          "Mode": "INLINE"
+        },
+        "StartAt": "Hello",
+        "States": {
+          "Hello": {
+            "Type": "Pass",
+            "Result": "Hello",
+            "End": true
+          }
+        }
+      },
+      "ItemsPath": "$.items",
+      "MaxConcurrency": 2,
+      "End": true
+    },
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Hello",
+          "States": {
+            "Hello": {
+              "Type": "Pass",
+              "Result": "Hello",
+              "End": true
+            }
+          }
+        },
+        {
+          "StartAt": "World",
+          "States": {
+            "World": {
+              "Type": "Succeed"
+            }
+          }
+        }
+      ],
+      "Next": "Each"
+    }
+  },
+  "TimeoutSeconds": 300
+}`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_waitZeroSeconds = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Wait"
+
+  state {
+    name    = "Wait"
+    type    = "Wait"
+    next    = "Done"
+    seconds = 0
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_waitZeroSeconds_ExpectedJSON = `{
+  "StartAt": "Wait",
+  "States": {
+    "Wait": {
+      "Type": "Wait",
+      "Seconds": 0,
+      "Next": "Done"
+    },
+    "Done": {
+      "Type": "Succeed"
+    }
+  }
+}`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_duplicateName = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Done"
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_invalid = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Start"
+
+  state {
+    name = "Start"
+    type = "Pass"
+    next = "Missing"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+}
+`
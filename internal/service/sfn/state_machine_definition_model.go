@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"encoding/json"
+)
+
+// stateMachineDefinition is an Amazon States Language state machine definition, or a nested state machine
+// such as a Parallel state branch.
+// See https://states-language.net/spec.html.
+type stateMachineDefinition struct {
+	Comment        string                        `json:",omitempty"`
+	StartAt        string                        `json:",omitempty"`
+	States         map[string]*stateMachineState `json:",omitempty"`
+	TimeoutSeconds int                           `json:",omitempty"`
+	Version        string                        `json:",omitempty"`
+}
+
+type stateMachineProcessorConfig struct {
+	ExecutionType string `json:",omitempty"`
+	Mode          string `json:",omitempty"`
+}
+
+type stateMachineState struct {
+	Type    string
+	Comment string `json:",omitempty"`
+
+	// Input and output processing.
+	InputPath      string          `json:",omitempty"`
+	Parameters     json.RawMessage `json:",omitempty"`
+	ResultSelector json.RawMessage `json:",omitempty"`
+	ResultPath     string          `json:",omitempty"`
+	OutputPath     string          `json:",omitempty"`
+
+	// Task state.
+	Resource         string `json:",omitempty"`
+	TimeoutSeconds   int    `json:",omitempty"`
+	HeartbeatSeconds int    `json:",omitempty"`
+
+	// Pass state.
+	Result json.RawMessage `json:",omitempty"`
+
+	// Choice state.
+	Choices []map[string]interface{} `json:",omitempty"`
+	Default string                   `json:",omitempty"`
+
+	// Wait state.
+	Seconds       *int   `json:",omitempty"`
+	SecondsPath   string `json:",omitempty"`
+	Timestamp     string `json:",omitempty"`
+	TimestampPath string `json:",omitempty"`
+
+	// Parallel state.
+	Branches []json.RawMessage `json:",omitempty"`
+
+	// Map state.
+	ItemProcessor  map[string]interface{} `json:",omitempty"`
+	ItemSelector   json.RawMessage        `json:",omitempty"`
+	ItemsPath      string                 `json:",omitempty"`
+	MaxConcurrency int                    `json:",omitempty"`
+
+	// Fail state.
+	Cause string `json:",omitempty"`
+	Error string `json:",omitempty"`
+
+	Retry []*stateMachineRetrier `json:",omitempty"`
+	Catch []*stateMachineCatcher `json:",omitempty"`
+
+	Next string `json:",omitempty"`
+	End  bool   `json:",omitempty"`
+}
+
+type stateMachineRetrier struct {
+	ErrorEquals     []string
+	IntervalSeconds int     `json:",omitempty"`
+	MaxAttempts     int     // Zero disables retries.
+	BackoffRate     float64 `json:",omitempty"`
+	MaxDelaySeconds int     `json:",omitempty"`
+	JitterStrategy  string  `json:",omitempty"`
+}
+
+type stateMachineCatcher struct {
+	ErrorEquals []string
+	ResultPath  string `json:",omitempty"`
+	Next        string
+}
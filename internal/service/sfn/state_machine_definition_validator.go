@@ -0,0 +1,536 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+const (
+	stateMachineQueryLanguageJSONata  = "JSONata"
+	stateMachineQueryLanguageJSONPath = "JSONPath"
+)
+
+const (
+	stateMachineStateTypeChoice   = "Choice"
+	stateMachineStateTypeFail     = "Fail"
+	stateMachineStateTypeMap      = "Map"
+	stateMachineStateTypeParallel = "Parallel"
+	stateMachineStateTypePass     = "Pass"
+	stateMachineStateTypeSucceed  = "Succeed"
+	stateMachineStateTypeTask     = "Task"
+	stateMachineStateTypeWait     = "Wait"
+)
+
+func stateMachineStateType_Values() []string {
+	return []string{
+		stateMachineStateTypeChoice,
+		stateMachineStateTypeFail,
+		stateMachineStateTypeMap,
+		stateMachineStateTypeParallel,
+		stateMachineStateTypePass,
+		stateMachineStateTypeSucceed,
+		stateMachineStateTypeTask,
+		stateMachineStateTypeWait,
+	}
+}
+
+// State fields whose values are JSONPaths, or intrinsic functions for some fields.
+var stateMachinePathFields = []string{
+	"CausePath",
+	"ErrorPath",
+	"HeartbeatSecondsPath",
+	"InputPath",
+	"ItemsPath",
+	"MaxConcurrencyPath",
+	"OutputPath",
+	"ResultPath",
+	"SecondsPath",
+	"TimeoutSecondsPath",
+	"TimestampPath",
+	"ToleratedFailureCountPath",
+	"ToleratedFailurePercentagePath",
+}
+
+// State fields whose values are payload templates, in which the values of fields with names ending in ".$" are JSONPaths or intrinsic functions.
+var stateMachinePayloadTemplateFields = []string{
+	"ItemSelector",
+	"Parameters",
+	"ResultSelector",
+}
+
+// validateStateMachineDefinition checks an Amazon States Language definition for errors that
+// would otherwise only be reported by AWS when the state machine is created or updated:
+// missing or unreachable states, invalid state types and states that neither transition nor end.
+// All errors found are returned.
+// Findings that AWS may accept, such as JSONPaths or intrinsic functions that this check can't parse
+// and state machines with no terminal state, are returned as warnings.
+func validateStateMachineDefinition(definition string) ([]string, error) {
+	var machine map[string]interface{}
+	if err := json.Unmarshal([]byte(definition), &machine); err != nil {
+		return nil, fmt.Errorf("parsing definition: %w", err)
+	}
+
+	v := &stateMachineDefinitionValidator{}
+	v.validateMachine("", machine, stateMachineQueryLanguageJSONPath)
+
+	return v.warnings, errors.Join(v.errs...)
+}
+
+type stateMachineDefinitionValidator struct {
+	errs     []error
+	warnings []string
+}
+
+func (v *stateMachineDefinitionValidator) errorf(location, format string, a ...any) {
+	v.errs = append(v.errs, errors.New(stateMachineDefinitionFinding(location, format, a...)))
+}
+
+func (v *stateMachineDefinitionValidator) warnf(location, format string, a ...any) {
+	v.warnings = append(v.warnings, stateMachineDefinitionFinding(location, format, a...))
+}
+
+func stateMachineDefinitionFinding(location, format string, a ...any) string {
+	if location == "" {
+		location = "/"
+	}
+
+	return fmt.Sprintf("%s: %s", location, fmt.Sprintf(format, a...))
+}
+
+// validateMachine validates a state machine or a nested state machine, such as a Parallel state branch.
+func (v *stateMachineDefinitionValidator) validateMachine(location string, machine map[string]interface{}, queryLanguage string) {
+	if s, ok := machine["QueryLanguage"].(string); ok {
+		queryLanguage = s
+	}
+
+	startAt, ok := machine["StartAt"].(string)
+	if !ok || startAt == "" {
+		v.errorf(location, "StartAt is required")
+	}
+
+	states, ok := machine["States"].(map[string]interface{})
+	if !ok || len(states) == 0 {
+		v.errorf(location, "States is required")
+		return
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			v.errorf(location, "StartAt state (%s) not found", startAt)
+		}
+	}
+
+	terminal := false
+	names := tfmaps.Keys(states)
+	slices.Sort(names)
+
+	for _, name := range names {
+		stateLocation := location + "/States/" + name
+
+		state, ok := states[name].(map[string]interface{})
+		if !ok {
+			v.errorf(stateLocation, "state must be an object")
+			continue
+		}
+
+		if v.validateState(stateLocation, state, states, queryLanguage) {
+			terminal = true
+		}
+	}
+
+	if !terminal {
+		v.warnf(location, "no terminal state: at least one state must be a Succeed or Fail state or have End set to true")
+	}
+
+	if startAt == "" {
+		return
+	}
+
+	reachable := map[string]struct{}{}
+	queue := []string{startAt}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if _, ok := reachable[name]; ok {
+			continue
+		}
+		reachable[name] = struct{}{}
+
+		if state, ok := states[name].(map[string]interface{}); ok {
+			queue = append(queue, stateMachineStateTransitions(state)...)
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := reachable[name]; !ok {
+			v.errorf(location+"/States/"+name, "state is not reachable from StartAt state (%s)", startAt)
+		}
+	}
+}
+
+// validateState validates a single state and reports whether it is a terminal state.
+func (v *stateMachineDefinitionValidator) validateState(location string, state map[string]interface{}, states map[string]interface{}, queryLanguage string) bool {
+	if s, ok := state["QueryLanguage"].(string); ok {
+		queryLanguage = s
+	}
+
+	stateType, _ := state["Type"].(string)
+	if !slices.Contains(stateMachineStateType_Values(), stateType) {
+		v.errorf(location, "Type (%s) must be one of %s", stateType, strings.Join(stateMachineStateType_Values(), ", "))
+		return false
+	}
+
+	checkTarget := func(location, field string, value interface{}) {
+		name, ok := value.(string)
+		if !ok || name == "" {
+			v.errorf(location, "%s is required", field)
+			return
+		}
+
+		if _, ok := states[name]; !ok {
+			v.errorf(location, "%s state (%s) not found", field, name)
+		}
+	}
+
+	next, hasNext := state["Next"]
+	end, _ := state["End"].(bool)
+	terminal := false
+
+	switch stateType {
+	case stateMachineStateTypeChoice, stateMachineStateTypeFail, stateMachineStateTypeSucceed:
+		if hasNext {
+			v.errorf(location, "%s state must not have Next", stateType)
+		}
+		if _, ok := state["End"]; ok {
+			v.errorf(location, "%s state must not have End", stateType)
+		}
+
+		terminal = stateType != stateMachineStateTypeChoice
+	default:
+		switch {
+		case hasNext && end:
+			v.errorf(location, "only one of Next or End can be set")
+		case hasNext:
+			checkTarget(location, "Next", next)
+		case end:
+			terminal = true
+		default:
+			v.errorf(location, "one of Next or End must be set")
+		}
+	}
+
+	if catchers, ok := state["Catch"].([]interface{}); ok {
+		switch stateType {
+		case stateMachineStateTypeMap, stateMachineStateTypeParallel, stateMachineStateTypeTask:
+			for i, catcher := range catchers {
+				catcher, _ := catcher.(map[string]interface{})
+				checkTarget(fmt.Sprintf("%s/Catch/%d", location, i), "Next", catcher["Next"])
+			}
+		default:
+			v.errorf(location, "%s state must not have Catch", stateType)
+		}
+	}
+
+	switch stateType {
+	case stateMachineStateTypeChoice:
+		choices, ok := state["Choices"].([]interface{})
+		if !ok || len(choices) == 0 {
+			v.errorf(location, "Choices is required")
+		}
+
+		for i, choice := range choices {
+			choiceLocation := fmt.Sprintf("%s/Choices/%d", location, i)
+			rule, _ := choice.(map[string]interface{})
+
+			checkTarget(choiceLocation, "Next", rule["Next"])
+
+			if queryLanguage != stateMachineQueryLanguageJSONata {
+				v.validateChoiceRule(choiceLocation, rule)
+			}
+		}
+
+		if value, ok := state["Default"]; ok {
+			checkTarget(location, "Default", value)
+		}
+	case stateMachineStateTypeMap:
+		for _, field := range []string{"ItemProcessor", "Iterator"} {
+			if machine, ok := state[field].(map[string]interface{}); ok {
+				v.validateMachine(location+"/"+field, machine, queryLanguage)
+			}
+		}
+	case stateMachineStateTypeParallel:
+		branches, ok := state["Branches"].([]interface{})
+		if !ok || len(branches) == 0 {
+			v.errorf(location, "Branches is required")
+		}
+
+		for i, branch := range branches {
+			branchLocation := fmt.Sprintf("%s/Branches/%d", location, i)
+
+			if machine, ok := branch.(map[string]interface{}); ok {
+				v.validateMachine(branchLocation, machine, queryLanguage)
+			} else {
+				v.errorf(branchLocation, "branch must be an object")
+			}
+		}
+	}
+
+	if queryLanguage == stateMachineQueryLanguageJSONata {
+		return terminal
+	}
+
+	for _, field := range stateMachinePathFields {
+		if path, ok := state[field].(string); ok {
+			if err := validateStateMachinePathOrIntrinsicFunction(path); err != nil {
+				v.warnf(location, "%s: %s", field, err)
+			}
+		}
+	}
+
+	for _, field := range stateMachinePayloadTemplateFields {
+		if template, ok := state[field]; ok {
+			v.validatePayloadTemplate(location+"/"+field, template)
+		}
+	}
+
+	return terminal
+}
+
+func (v *stateMachineDefinitionValidator) validateChoiceRule(location string, rule map[string]interface{}) {
+	fields := tfmaps.Keys(rule)
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		value := rule[field]
+
+		switch field {
+		case "And", "Or":
+			rules, _ := value.([]interface{})
+			for i, rule := range rules {
+				rule, _ := rule.(map[string]interface{})
+				v.validateChoiceRule(fmt.Sprintf("%s/%s/%d", location, field, i), rule)
+			}
+		case "Not":
+			rule, _ := value.(map[string]interface{})
+			v.validateChoiceRule(location+"/Not", rule)
+		case "Variable":
+			if path, ok := value.(string); ok {
+				if err := validateStateMachinePath(path); err != nil {
+					v.warnf(location, "%s: %s", field, err)
+				}
+			}
+		default:
+			// Comparison operators such as StringEqualsPath compare with the value at a JSONPath.
+			if path, ok := value.(string); ok && strings.HasSuffix(field, "Path") {
+				if err := validateStateMachinePath(path); err != nil {
+					v.warnf(location, "%s: %s", field, err)
+				}
+			}
+		}
+	}
+}
+
+func (v *stateMachineDefinitionValidator) validatePayloadTemplate(location string, template interface{}) {
+	switch template := template.(type) {
+	case map[string]interface{}:
+		fields := tfmaps.Keys(template)
+		slices.Sort(fields)
+
+		for _, field := range fields {
+			value := template[field]
+
+			if s, ok := value.(string); ok && strings.HasSuffix(field, ".$") {
+				if err := validateStateMachinePathOrIntrinsicFunction(s); err != nil {
+					v.warnf(location, "%s: %s", field, err)
+				}
+
+				continue
+			}
+
+			v.validatePayloadTemplate(location+"/"+field, value)
+		}
+	case []interface{}:
+		for i, value := range template {
+			v.validatePayloadTemplate(fmt.Sprintf("%s/%d", location, i), value)
+		}
+	}
+}
+
+// stateMachineStateTransitions returns the names of the states that a state can transition to.
+func stateMachineStateTransitions(state map[string]interface{}) []string {
+	var names []string
+
+	if s, ok := state["Next"].(string); ok {
+		names = append(names, s)
+	}
+
+	if s, ok := state["Default"].(string); ok {
+		names = append(names, s)
+	}
+
+	for _, field := range []string{"Catch", "Choices"} {
+		if values, ok := state[field].([]interface{}); ok {
+			for _, value := range values {
+				if value, ok := value.(map[string]interface{}); ok {
+					if s, ok := value["Next"].(string); ok {
+						names = append(names, s)
+					}
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+func validateStateMachinePathOrIntrinsicFunction(s string) error {
+	if strings.HasPrefix(s, "States.") {
+		return validateStateMachineIntrinsicFunction(s)
+	}
+
+	return validateStateMachinePath(s)
+}
+
+func validateStateMachineIntrinsicFunction(s string) error {
+	if !regexache.MustCompile(`^States\.[A-Za-z][0-9A-Za-z.]*\(.*\)$`).MatchString(s) {
+		return fmt.Errorf("invalid intrinsic function (%s)", s)
+	}
+
+	if err := validateStateMachineBrackets(s); err != nil {
+		return fmt.Errorf("invalid intrinsic function (%s): %w", s, err)
+	}
+
+	return nil
+}
+
+// validateStateMachinePath checks the syntax of a JSONPath, which refers to either the state input ("$") or the context object ("$$").
+func validateStateMachinePath(s string) error {
+	rest, ok := strings.CutPrefix(s, "$")
+	if !ok {
+		return fmt.Errorf("invalid JSONPath (%s): must start with $", s)
+	}
+	rest = strings.TrimPrefix(rest, "$")
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = strings.TrimPrefix(rest[1:], ".") // Deep scan.
+
+			i := strings.IndexAny(rest, ".[]")
+			if i == -1 {
+				i = len(rest)
+			}
+
+			if i == 0 {
+				return fmt.Errorf("invalid JSONPath (%s): empty field name", s)
+			}
+
+			rest = rest[i:]
+		case '[':
+			end, err := stateMachinePathBracketEnd(rest)
+			if err != nil {
+				return fmt.Errorf("invalid JSONPath (%s): %w", s, err)
+			}
+
+			if strings.TrimSpace(rest[1:end]) == "" {
+				return fmt.Errorf("invalid JSONPath (%s): empty subscript", s)
+			}
+
+			rest = rest[end+1:]
+		default:
+			return fmt.Errorf("invalid JSONPath (%s): unexpected character %q", s, rest[0])
+		}
+	}
+
+	return nil
+}
+
+// stateMachinePathBracketEnd returns the index of the ']' that closes the '[' at the start of s.
+func stateMachinePathBracketEnd(s string) (int, error) {
+	depth := 0
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+			if depth < 0 {
+				return 0, fmt.Errorf("unbalanced %q", c)
+			}
+			if depth == 0 {
+				if c != ']' {
+					return 0, fmt.Errorf("unbalanced %q", c)
+				}
+				return i, nil
+			}
+		}
+	}
+
+	return 0, errors.New("unclosed '['")
+}
+
+// validateStateMachineBrackets checks that the brackets and quotes in s are balanced.
+func validateStateMachineBrackets(s string) error {
+	var stack []byte
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[' || c == '(':
+			stack = append(stack, c)
+		case c == ']' || c == ')':
+			open := byte('[')
+			if c == ')' {
+				open = '('
+			}
+
+			if len(stack) == 0 || stack[len(stack)-1] != open {
+				return fmt.Errorf("unbalanced %q", c)
+			}
+
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		return errors.New("unclosed quote")
+	}
+
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+
+	return nil
+}
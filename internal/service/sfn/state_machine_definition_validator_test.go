@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestValidateStateMachineDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition   string
+		wantErrs     []string
+		wantWarnings []string
+	}{
+		"valid": {
+			definition: `{
+  "Comment": "Example",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.kind", "StringEquals": "a", "Next": "Work"},
+        {"And": [{"Variable": "$.count", "NumericGreaterThan": 1}, {"Not": {"Variable": "$['x y']", "IsPresent": true}}], "Next": "Fan"}
+      ],
+      "Default": "Done"
+    },
+    "Work": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"FunctionName": "example", "Payload.$": "$", "Nested": {"Id.$": "States.Format('{}-x', $.id)"}},
+      "ResultSelector": {"Body.$": "$.Payload"},
+      "ResultPath": "$.result",
+      "Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 2}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+      "Next": "Wait"
+    },
+    "Wait": {"Type": "Wait", "SecondsPath": "$.delay", "Next": "Done"},
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}},
+        {"StartAt": "B", "States": {"B": {"Type": "Succeed"}}}
+      ],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemsPath": "$.items[*]",
+      "ItemProcessor": {"ProcessorConfig": {"Mode": "INLINE"}, "StartAt": "Item", "States": {"Item": {"Type": "Pass", "End": true}}},
+      "End": true
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "Example", "CausePath": "$$.Execution.Id"}
+  }
+}`,
+		},
+		"invalid JSON": {
+			definition: `{`,
+			wantErrs:   []string{`parsing definition`},
+		},
+		"missing StartAt state": {
+			definition: `{"StartAt": "Missing", "States": {"A": {"Type": "Succeed"}}}`,
+			wantErrs: []string{
+				`^/: StartAt state \(Missing\) not found`,
+				`/States/A: state is not reachable`,
+			},
+		},
+		"missing Next state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}, "C": {"Type": "Succeed"}}}`,
+			wantErrs: []string{
+				`/States/A: Next state \(B\) not found`,
+				`/States/C: state is not reachable`,
+			},
+		},
+		"Next and End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+			wantErrs: []string{
+				`/States/A: only one of Next or End can be set`,
+			},
+		},
+		"no transition": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn"}}}`,
+			wantErrs: []string{
+				`/States/A: one of Next or End must be set`,
+			},
+			wantWarnings: []string{
+				`^/: no terminal state`,
+			},
+		},
+		"no terminal state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}, "B": {"Type": "Pass", "Next": "A"}}}`,
+			wantWarnings: []string{
+				`^/: no terminal state`,
+			},
+		},
+		"invalid Type": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Sleep", "End": true}}}`,
+			wantErrs: []string{
+				`/States/A: Type \(Sleep\) must be one of`,
+			},
+			wantWarnings: []string{
+				`^/: no terminal state`,
+			},
+		},
+		"Choice state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "BooleanEquals": true, "Next": "C"}, {"Variable": "x", "IsNull": true}], "Default": "D", "End": true}, "B": {"Type": "Succeed"}}}`,
+			wantErrs: []string{
+				`/States/A: Choice state must not have End`,
+				`/States/A/Choices/0: Next state \(C\) not found`,
+				`/States/A/Choices/1: Next is required`,
+				`/States/A: Default state \(D\) not found`,
+				`/States/B: state is not reachable`,
+			},
+			wantWarnings: []string{
+				`/States/A/Choices/1: Variable: invalid JSONPath \(x\): must start with \$`,
+			},
+		},
+		"Catch": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "B"}], "End": true}, "B": {"Type": "Succeed"}}}`,
+			wantErrs: []string{
+				`/States/A: Pass state must not have Catch`,
+			},
+		},
+		"Parallel branch": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [{"StartAt": "X", "States": {"Y": {"Type": "Succeed"}}}], "End": true}}}`,
+			wantErrs: []string{
+				`/States/A/Branches/0: StartAt state \(X\) not found`,
+				`/States/A/Branches/0/States/Y: state is not reachable`,
+			},
+		},
+		"Map item processor": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Map", "ItemProcessor": {"StartAt": "X", "States": {"X": {"Type": "Pass", "Next": "Z"}}}, "End": true}}}`,
+			wantErrs: []string{
+				`/States/A/ItemProcessor/States/X: Next state \(Z\) not found`,
+			},
+			wantWarnings: []string{
+				`/States/A/ItemProcessor: no terminal state`,
+			},
+		},
+		"JSONPaths": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "$.a[0", "OutputPath": "$..", "ResultPath": "$.b['c']", "Parameters": {"x.$": "$.y]", "z.$": "States.Format('{}'", "list": [{"w.$": "w"}], "literal": "$.not.checked"}, "End": true}}}`,
+			wantWarnings: []string{
+				`/States/A: InputPath: invalid JSONPath \(\$\.a\[0\): unclosed '\['`,
+				`/States/A: OutputPath: invalid JSONPath \(\$\.\.\): empty field name`,
+				`/States/A/Parameters: x\.\$: invalid JSONPath \(\$\.y\]\): unexpected character`,
+				`/States/A/Parameters: z\.\$: invalid intrinsic function`,
+				`/States/A/Parameters/list/0: w\.\$: invalid JSONPath \(w\): must start with \$`,
+			},
+		},
+		"JSONata": {
+			definition: `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Pass", "Output": "{% $states.input %}", "End": true}}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := validateStateMachineDefinition(testCase.definition)
+
+			var errs []string
+			if err != nil {
+				errs = regexache.MustCompile(`\n`).Split(err.Error(), -1)
+			}
+
+			testValidateStateMachineDefinitionFindings(t, "error", errs, testCase.wantErrs)
+			testValidateStateMachineDefinitionFindings(t, "warning", warnings, testCase.wantWarnings)
+		})
+	}
+}
+
+func testValidateStateMachineDefinitionFindings(t *testing.T, kind string, got, want []string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Errorf("got %d %ss, want %d:\n%s", len(got), kind, len(want), strings.Join(got, "\n"))
+	}
+
+	for _, want := range want {
+		re := regexache.MustCompile(want)
+
+		if !slices.ContainsFunc(got, re.MatchString) {
+			t.Errorf("expected %s matching %q, got:\n%s", kind, want, strings.Join(got, "\n"))
+		}
+	}
+}
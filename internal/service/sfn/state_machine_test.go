@@ -400,6 +400,24 @@ func TestAccSFNStateMachine_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_invalidDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_invalidDefinition(rName),
+				ExpectError: regexache.MustCompile(`invalid definition: /States/HelloWorld: Next state \(Missing\) not found`),
+			},
+		},
+	})
+}
+
 func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sm sfn.DescribeStateMachineOutput
@@ -950,3 +968,27 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineConfig_invalidDefinition(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Pass",
+      "Next": "Missing"
+    },
+    "Done": {
+      "Type": "Succeed"
+    }
+  }
+}
+EOF
+}
+`, rName))
+}
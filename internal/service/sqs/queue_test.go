@@ -7,12 +7,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -90,6 +92,94 @@ func TestQueueNameFromURL(t *testing.T) {
 	}
 }
 
+func TestFindQueueAttributesByURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	server := acctest.NewMockServer(t)
+	queueURL := fmt.Sprintf("%s/%s/tf-acc-test", server.Endpoint(names.SQS), acctest.MockAccountID)
+
+	server.Handle(names.SQS, "GetQueueAttributes", acctest.MockSequence(
+		acctest.MockJSONResponse(map[string]any{
+			"Attributes": map[string]string{
+				"DelaySeconds": "90",
+			},
+		}),
+		acctest.MockErrorResponse(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist."),
+	))
+
+	conn := server.Client(ctx, t).SQSClient(ctx)
+
+	attributes, err := tfsqs.FindQueueAttributesByURL(ctx, conn, queueURL)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := attributes[types.QueueAttributeNameDelaySeconds], "90"; got != want {
+		t.Errorf("DelaySeconds = %q, want %q", got, want)
+	}
+
+	_, err = tfsqs.FindQueueAttributesByURL(ctx, conn, queueURL)
+
+	if !tfresource.NotFound(err) {
+		t.Errorf("expected NotFound error, got: %v", err)
+	}
+
+	var input map[string]any
+	if err := server.Requests(names.SQS, "GetQueueAttributes")[0].DecodeJSONBody(&input); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := input["QueueUrl"], queueURL; got != want {
+		t.Errorf("QueueUrl = %v, want %v", got, want)
+	}
+}
+
+func TestQueueRead_notFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	server := acctest.NewMockServer(t)
+	queueURL := fmt.Sprintf("%s/%s/tf-acc-test", server.Endpoint(names.SQS), acctest.MockAccountID)
+
+	server.Handle(names.SQS, "GetQueueAttributes", acctest.MockErrorResponse(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist."))
+
+	r := tfsqs.ResourceQueue()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	d.SetId(queueURL)
+
+	if diags := r.ReadWithoutTimeout(ctx, d, server.Client(ctx, t)); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected resource to be removed from state, got ID %q", d.Id())
+	}
+}
+
+func TestQueueDelete_notFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	server := acctest.NewMockServer(t)
+	queueURL := fmt.Sprintf("%s/%s/tf-acc-test", server.Endpoint(names.SQS), acctest.MockAccountID)
+
+	server.Handle(names.SQS, "DeleteQueue", acctest.MockErrorResponse(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist."))
+
+	r := tfsqs.ResourceQueue()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	d.SetId(queueURL)
+
+	if diags := r.DeleteWithoutTimeout(ctx, d, server.Client(ctx, t)); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := len(server.Requests(names.SQS, "GetQueueAttributes")), 0; got != want {
+		t.Errorf("GetQueueAttributes requests = %d, want %d", got, want)
+	}
+}
+
 func TestAccSQSQueue_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
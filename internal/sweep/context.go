@@ -9,6 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
+type contextKey int
+
+const (
+	contextKeyRegion contextKey = iota
+	contextKeyResourceType
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -16,5 +23,26 @@ func Context(region string) context.Context {
 
 	ctx = logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, contextKeyRegion, region)
+
 	return ctx
 }
+
+// withResourceType returns a context for sweeping the specified resource type.
+func withResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = logWithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, contextKeyResourceType, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(contextKeyRegion).(string)
+
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(contextKeyResourceType).(string)
+
+	return v
+}
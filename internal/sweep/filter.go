@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+// Resource filtering and dry-run mode applied by SweepOrchestrator and RunSweepers.
+// These are set in TestMain.
+var (
+	// DeletionFilter selects the resources found by sweepers that may be deleted.
+	DeletionFilter *filter.Filter
+
+	// DryRun disables deletion of the resources found by sweepers.
+	DryRun bool
+)
+
+const (
+	ReportActionDelete = "delete"
+	ReportActionSkip   = "skip"
+)
+
+// ReportEntry is the JSON report of a single resource found by a sweeper.
+type ReportEntry struct {
+	Region       string            `json:"region,omitempty"`
+	ResourceType string            `json:"resource_type,omitempty"`
+	ID           string            `json:"id"`
+	Name         string            `json:"name,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	CreatedAt    *time.Time        `json:"created_at,omitempty"`
+	Action       string            `json:"action"`
+	Reason       string            `json:"reason,omitempty"`
+	DryRun       bool              `json:"dry_run"`
+}
+
+type reporter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+var report *reporter
+
+// SetReportWriter sets the writer that receives a report of each resource found by sweepers,
+// as one JSON object (ReportEntry) per line.
+func SetReportWriter(w io.Writer) {
+	report = &reporter{
+		enc: json.NewEncoder(w),
+	}
+}
+
+func (r *reporter) write(ctx context.Context, entry ReportEntry) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.enc.Encode(entry); err != nil {
+		tflog.Warn(ctx, "Writing sweeper report", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+// filterSweepables returns the resources that the specified filter allows to be deleted.
+// Each resource is reported along with its planned action.
+func filterSweepables(ctx context.Context, sweepables []Sweepable, f *filter.Filter, dryRun bool) []Sweepable {
+	now := time.Now()
+	region, resourceType := regionFromContext(ctx), resourceTypeFromContext(ctx)
+
+	var result []Sweepable
+
+	for _, sweepable := range sweepables {
+		entry := ReportEntry{
+			Region:       region,
+			ResourceType: resourceType,
+			ID:           describeSweepable(sweepable),
+			DryRun:       dryRun,
+		}
+
+		var metadata *filter.Metadata
+		if v, ok := sweepable.(filter.Describer); ok {
+			m := v.Metadata(ctx)
+			metadata = &m
+
+			entry.ID = m.ID
+			entry.Name = m.Name
+			entry.Tags = m.Tags
+			if !m.CreatedAt.IsZero() {
+				entry.CreatedAt = &m.CreatedAt
+			}
+		}
+
+		if ok, reason := f.Evaluate(metadata, now); ok {
+			entry.Action = ReportActionDelete
+			result = append(result, sweepable)
+		} else {
+			entry.Action = ReportActionSkip
+			entry.Reason = reason
+
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"id":     entry.ID,
+				"reason": reason,
+			})
+		}
+
+		report.write(ctx, entry)
+	}
+
+	return result
+}
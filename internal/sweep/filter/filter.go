@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CreationTimeAttributes are the names of attributes that commonly hold a resource's creation timestamp.
+// The first one set, in this order, is used as the resource's creation time.
+var CreationTimeAttributes = []string{
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"create_date",
+	"create_time",
+}
+
+// Metadata describes a resource found by a sweeper.
+type Metadata struct {
+	ID        string
+	Name      string
+	Tags      map[string]string // Nil if unknown
+	CreatedAt time.Time         // Zero if unknown
+}
+
+// Describer is implemented by sweepable resources that can describe themselves for filtering.
+type Describer interface {
+	Metadata(context.Context) Metadata
+}
+
+// Tag matches a resource tag.
+// An empty Value matches any value.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+func (t Tag) String() string {
+	if t.Value == "" {
+		return t.Key
+	}
+
+	return t.Key + "=" + t.Value
+}
+
+func (t Tag) matches(tags map[string]string) bool {
+	v, ok := tags[t.Key]
+
+	return ok && (t.Value == "" || t.Value == v)
+}
+
+// ParseTags parses a comma-separated list of tags of the form `key` or `key=value`.
+func ParseTags(s string) ([]Tag, error) {
+	var tags []Tag
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(v, "=")
+		if key == "" {
+			return nil, fmt.Errorf("invalid tag (%s): empty key", v)
+		}
+
+		tags = append(tags, Tag{Key: key, Value: value})
+	}
+
+	return tags, nil
+}
+
+// ParseCreationTime parses a creation timestamp attribute value.
+func ParseCreationTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// Filter selects the resources found by sweepers that may be deleted.
+type Filter struct {
+	// NamePrefixes, if set, restricts deletion to resources whose name (or ID, if unnamed) has one of the prefixes.
+	NamePrefixes []string
+	// IncludeTags, if set, restricts deletion to resources with at least one of the tags.
+	IncludeTags []Tag
+	// ExcludeTags prevents deletion of resources with any of the tags.
+	// Resources whose tags are unknown are not deleted.
+	ExcludeTags []Tag
+	// MinAge prevents deletion of resources created less than this long ago.
+	// Resources whose creation time is unknown are not deleted.
+	MinAge time.Duration
+}
+
+// IsEmpty returns whether the filter allows deletion of all resources.
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (len(f.NamePrefixes) == 0 && len(f.IncludeTags) == 0 && len(f.ExcludeTags) == 0 && f.MinAge == 0)
+}
+
+// Evaluate returns whether the resource described by the specified metadata may be deleted and, if not, why not.
+// A nil Metadata indicates a resource that cannot describe itself; such resources are only deleted if
+// the filter is empty.
+func (f *Filter) Evaluate(m *Metadata, now time.Time) (bool, string) {
+	if f.IsEmpty() {
+		return true, ""
+	}
+
+	if m == nil {
+		return false, "resource metadata is not available"
+	}
+
+	if (len(f.ExcludeTags) > 0 || len(f.IncludeTags) > 0) && m.Tags == nil {
+		return false, "tags unknown"
+	}
+
+	for _, tag := range f.ExcludeTags {
+		if tag.matches(m.Tags) {
+			return false, fmt.Sprintf("tag (%s) is excluded", tag)
+		}
+	}
+
+	if len(f.IncludeTags) > 0 && !slices.ContainsFunc(f.IncludeTags, func(tag Tag) bool { return tag.matches(m.Tags) }) {
+		return false, "no included tag"
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		name := m.Name
+		if name == "" {
+			name = m.ID
+		}
+
+		if !slices.ContainsFunc(f.NamePrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) }) {
+			return false, fmt.Sprintf("name (%s) has no allowed prefix", name)
+		}
+	}
+
+	if f.MinAge > 0 {
+		if m.CreatedAt.IsZero() {
+			return false, "creation time unknown"
+		}
+
+		if age := now.Sub(m.CreatedAt); age < f.MinAge {
+			return false, fmt.Sprintf("created %s ago, less than minimum age %s", age.Truncate(time.Second), f.MinAge)
+		}
+	}
+
+	return true, ""
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected []Tag
+		wantErr  bool
+	}{
+		"empty": {
+			input: "",
+		},
+		"keys and values": {
+			input:    "Owner=platform, keep ,Environment=",
+			expected: []Tag{{Key: "Owner", Value: "platform"}, {Key: "keep"}, {Key: "Environment"}},
+		},
+		"empty key": {
+			input:   "=value",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTags(testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ParseTags err %t, want %t", got, want)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCreationTime(t *testing.T) {
+	t.Parallel()
+
+	if got, ok := ParseCreationTime("2024-01-02T03:04:05Z"); !ok || !got.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected result: %s, %t", got, ok)
+	}
+
+	if _, ok := ParseCreationTime("yesterday"); ok {
+		t.Error("expected parse failure")
+	}
+}
+
+func TestFilterEvaluate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter   *Filter
+		metadata *Metadata
+		expected bool
+	}{
+		"nil filter": {
+			metadata: &Metadata{ID: "i-123"},
+			expected: true,
+		},
+		"no metadata, denylist only": {
+			filter: &Filter{ExcludeTags: []Tag{{Key: "keep"}}},
+		},
+		"no metadata, name prefix": {
+			filter: &Filter{NamePrefixes: []string{"tf-acc-test-"}},
+		},
+		"name prefix match": {
+			filter:   &Filter{NamePrefixes: []string{"tf-acc-test-", "tf-test-"}},
+			metadata: &Metadata{ID: "arn", Name: "tf-test-123"},
+			expected: true,
+		},
+		"name prefix no match": {
+			filter:   &Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			metadata: &Metadata{ID: "arn", Name: "production-queue"},
+		},
+		"name prefix falls back to ID": {
+			filter:   &Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			metadata: &Metadata{ID: "tf-acc-test-123"},
+			expected: true,
+		},
+		"excluded tag key": {
+			filter:   &Filter{ExcludeTags: []Tag{{Key: "keep"}}},
+			metadata: &Metadata{Tags: map[string]string{"keep": "forever"}},
+		},
+		"excluded tag, unknown tags": {
+			filter:   &Filter{ExcludeTags: []Tag{{Key: "keep"}}},
+			metadata: &Metadata{ID: "i-123"},
+		},
+		"excluded tag, no tags": {
+			filter:   &Filter{ExcludeTags: []Tag{{Key: "keep"}}},
+			metadata: &Metadata{ID: "i-123", Tags: map[string]string{}},
+			expected: true,
+		},
+		"excluded tag value mismatch": {
+			filter:   &Filter{ExcludeTags: []Tag{{Key: "Owner", Value: "platform"}}},
+			metadata: &Metadata{Tags: map[string]string{"Owner": "ci"}},
+			expected: true,
+		},
+		"included tag missing": {
+			filter:   &Filter{IncludeTags: []Tag{{Key: "Owner", Value: "ci"}}},
+			metadata: &Metadata{Tags: map[string]string{"Owner": "platform"}},
+		},
+		"included tag present": {
+			filter:   &Filter{IncludeTags: []Tag{{Key: "Owner", Value: "ci"}}},
+			metadata: &Metadata{Tags: map[string]string{"Owner": "ci"}},
+			expected: true,
+		},
+		"excluded wins over included": {
+			filter:   &Filter{IncludeTags: []Tag{{Key: "Owner"}}, ExcludeTags: []Tag{{Key: "keep"}}},
+			metadata: &Metadata{Tags: map[string]string{"Owner": "ci", "keep": ""}},
+		},
+		"too young": {
+			filter:   &Filter{MinAge: 24 * time.Hour},
+			metadata: &Metadata{CreatedAt: now.Add(-1 * time.Hour)},
+		},
+		"old enough": {
+			filter:   &Filter{MinAge: 24 * time.Hour},
+			metadata: &Metadata{CreatedAt: now.Add(-48 * time.Hour)},
+			expected: true,
+		},
+		"unknown age": {
+			filter:   &Filter{MinAge: 24 * time.Hour},
+			metadata: &Metadata{ID: "i-123"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.filter.Evaluate(testCase.metadata, now)
+
+			if got != testCase.expected {
+				t.Errorf("Evaluate = %t (%s), want %t", got, reason, testCase.expected)
+			}
+
+			if !got && reason == "" {
+				t.Error("expected reason")
+			}
+		})
+	}
+}
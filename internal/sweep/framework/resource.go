@@ -5,7 +5,9 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,7 +21,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -48,6 +52,60 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+func (sr *sweepResource) String() string {
+	var sb strings.Builder
+	for i, attr := range sr.attributes {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%s=%v", attr.path, attr.value)
+	}
+	return sb.String()
+}
+
+// Metadata describes the resource for filtering using any ID, name, tags and creation time attributes.
+func (sr *sweepResource) Metadata(context.Context) filter.Metadata {
+	var m filter.Metadata
+
+	for _, attr := range sr.attributes {
+		switch attr.path {
+		case names.AttrID:
+			m.ID = fmt.Sprintf("%v", attr.value)
+		case names.AttrName:
+			if v, ok := attr.value.(string); ok {
+				m.Name = v
+			}
+		case names.AttrTags, names.AttrTagsAll:
+			if v, ok := attr.value.(map[string]string); ok && m.Tags == nil {
+				// The tags are known, even if there are none.
+				if v == nil {
+					v = map[string]string{}
+				}
+				m.Tags = v
+			}
+		default:
+			if !m.CreatedAt.IsZero() || !slices.Contains(filter.CreationTimeAttributes, attr.path) {
+				continue
+			}
+
+			switch v := attr.value.(type) {
+			case time.Time:
+				m.CreatedAt = v
+			case string:
+				if t, ok := filter.ParseCreationTime(v); ok {
+					m.CreatedAt = t
+				}
+			}
+		}
+	}
+
+	if m.ID == "" {
+		m.ID = sr.String()
+	}
+
+	return m
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 
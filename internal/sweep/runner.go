@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"text/tabwriter"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+const defaultSweeperParallelism = 4
+
+// sweeper is a sweeper registered via Register.
+type sweeper struct {
+	name         string
+	f            SweeperFn
+	dependencies []string
+}
+
+// registeredSweepers contains all sweepers registered via Register, keyed by name.
+var registeredSweepers = make(map[string]*sweeper)
+
+type SweeperStatus string
+
+const (
+	// SweeperStatusSwept indicates that the sweeper ran and all found resources were deleted.
+	SweeperStatusSwept SweeperStatus = "swept"
+	// SweeperStatusListed indicates that the sweeper ran in dry-run mode and found resources were only listed.
+	SweeperStatusListed SweeperStatus = "listed"
+	// SweeperStatusSkipped indicates that the sweeper did not run, either because the service is not supported
+	// or because one of its prerequisite sweepers did not complete successfully.
+	SweeperStatusSkipped SweeperStatus = "skipped"
+	// SweeperStatusFailed indicates that the sweeper failed to list or delete resources.
+	SweeperStatusFailed SweeperStatus = "failed"
+)
+
+// SweeperSummary summarizes the result of running a single sweeper.
+type SweeperSummary struct {
+	Name   string
+	Status SweeperStatus
+	// Found is the number of resources found by the sweeper.
+	Found int
+	// Deleted is the number of resources successfully deleted.
+	Deleted int
+	// Skipped is the number of resources found but not deleted, either because they were excluded by the filter or in dry-run mode.
+	Skipped int
+	// Failed is the number of resources that could not be deleted.
+	Failed int
+	// Resources describes the resources that would be deleted in dry-run mode.
+	Resources []string
+	// SkipReason explains why a skipped sweeper did not run.
+	SkipReason string
+	// FailedPrerequisite is the name of the prerequisite sweeper whose failure caused this sweeper to be skipped.
+	FailedPrerequisite string
+	Err                error
+}
+
+// blocksDependents returns whether sweepers that depend on this one must be skipped.
+func (s *SweeperSummary) blocksDependents() bool {
+	return s.Status == SweeperStatusFailed || s.FailedPrerequisite != ""
+}
+
+type RunOptions struct {
+	Parallelism int            // Maximum number of sweepers run concurrently
+	DryRun      bool           // List resources that would be deleted without deleting them
+	Filter      *filter.Filter // Select the resources that may be deleted
+}
+
+type RunOptionsFunc func(*RunOptions)
+
+func WithParallelism(parallelism int) RunOptionsFunc {
+	return func(o *RunOptions) {
+		o.Parallelism = parallelism
+	}
+}
+
+func WithDryRun(dryRun bool) RunOptionsFunc {
+	return func(o *RunOptions) {
+		o.DryRun = dryRun
+	}
+}
+
+func WithFilter(f *filter.Filter) RunOptionsFunc {
+	return func(o *RunOptions) {
+		o.Filter = f
+	}
+}
+
+// RunSweepers runs the named sweepers registered via Register, and the sweepers that they depend on, in the specified Region.
+// If no names are specified all registered sweepers are run.
+// Sweepers are run in dependency order with independent sweepers run concurrently, and a sweeper is skipped
+// if any of its prerequisite sweepers does not complete successfully.
+// By default, DryRun and DeletionFilter are applied.
+// A summary for each sweeper is returned in the overall processing order.
+func RunSweepers(ctx context.Context, region string, names []string, optFns ...RunOptionsFunc) ([]*SweeperSummary, error) {
+	client, err := SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("getting client: %w", err)
+	}
+
+	return runSweepers(ctx, client, registeredSweepers, names, optFns...)
+}
+
+func runSweepers(ctx context.Context, client *conns.AWSClient, sweepers map[string]*sweeper, names []string, optFns ...RunOptionsFunc) ([]*SweeperSummary, error) {
+	opts := RunOptions{
+		Parallelism: defaultSweeperParallelism,
+		DryRun:      DryRun,
+		Filter:      DeletionFilter,
+	}
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+	if opts.Parallelism < 1 {
+		opts.Parallelism = 1
+	}
+
+	g, err := sweeperGraph(sweepers, names)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		summaries = make(map[string]*SweeperSummary, len(order))
+		done      = make(map[string]chan struct{}, len(order))
+		sem       = make(chan struct{}, opts.Parallelism)
+	)
+
+	for _, name := range order {
+		done[name] = make(chan struct{})
+	}
+
+	// Each sweeper waits for all of its prerequisites to complete before competing for a slot.
+	// The graph is acyclic, so this cannot deadlock.
+	for _, name := range order {
+		name := name
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[name])
+
+			for _, dependency := range dependencies {
+				<-done[dependency]
+			}
+
+			summary := &SweeperSummary{Name: name}
+
+			mu.Lock()
+			for _, dependency := range dependencies {
+				if summaries[dependency].blocksDependents() {
+					summary.Status = SweeperStatusSkipped
+					summary.FailedPrerequisite = dependency
+					summary.SkipReason = fmt.Sprintf("prerequisite sweeper %q did not complete successfully", dependency)
+					break
+				}
+			}
+			mu.Unlock()
+
+			if summary.Status == "" {
+				select {
+				case sem <- struct{}{}:
+					summary = sweepOne(ctx, client, sweepers[name], opts)
+					<-sem
+				case <-ctx.Done():
+					summary.Status = SweeperStatusFailed
+					summary.Err = ctx.Err()
+				}
+			}
+
+			mu.Lock()
+			summaries[name] = summary
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	result := make([]*SweeperSummary, 0, len(order))
+	for _, name := range order {
+		result = append(result, summaries[name])
+	}
+
+	return result, nil
+}
+
+// sweeperGraph returns the dependency graph of the named sweepers and their (transitive) dependencies.
+// All dependencies must be registered via Register.
+func sweeperGraph(sweepers map[string]*sweeper, names []string) (*depgraph.Graph, error) {
+	if len(names) == 0 {
+		for name := range sweepers {
+			names = append(names, name)
+		}
+	}
+	todo := slices.Clone(names)
+	slices.Sort(todo)
+
+	for _, name := range todo {
+		if _, ok := sweepers[name]; !ok {
+			return nil, fmt.Errorf("sweeper (%s) not found", name)
+		}
+	}
+
+	g := depgraph.New()
+	var nodes []string
+
+	for len(todo) > 0 {
+		name := todo[0]
+		todo = todo[1:]
+
+		if g.HasNode(name) {
+			continue
+		}
+		g.AddNode(name)
+		nodes = append(nodes, name)
+
+		for _, dependency := range sweepers[name].dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				return nil, fmt.Errorf("sweeper (%s) dependency (%s) not found", name, dependency)
+			}
+
+			todo = append(todo, dependency)
+		}
+	}
+
+	for _, name := range nodes {
+		for _, dependency := range sweepers[name].dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// sweepOne runs a single sweeper.
+func sweepOne(ctx context.Context, client *conns.AWSClient, s *sweeper, opts RunOptions) *SweeperSummary {
+	ctx = withResourceType(ctx, s.name)
+	summary := &SweeperSummary{Name: s.name}
+
+	sweepResources, err := s.f(ctx, client)
+
+	if awsv1.SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		summary.Status = SweeperStatusSkipped
+		summary.SkipReason = err.Error()
+		return summary
+	}
+	if err != nil {
+		summary.Status = SweeperStatusFailed
+		summary.Err = fmt.Errorf("listing %q: %w", s.name, err)
+		return summary
+	}
+
+	summary.Found = len(sweepResources)
+	sweepResources = filterSweepables(ctx, sweepResources, opts.Filter, opts.DryRun)
+	summary.Skipped = summary.Found - len(sweepResources)
+
+	if opts.DryRun {
+		for _, sweepable := range sweepResources {
+			summary.Resources = append(summary.Resources, describeSweepable(sweepable))
+		}
+		summary.Status = SweeperStatusListed
+		summary.Skipped = summary.Found
+		return summary
+	}
+
+	if len(sweepResources) == 0 {
+		tflog.Info(ctx, "No resources to sweep")
+	}
+
+	errs := make([]error, len(sweepResources))
+	var wg sync.WaitGroup
+
+	for i, sweepable := range sweepResources {
+		i, sweepable := i, sweepable
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = sweepable.Delete(ctx, ThrottlingRetryTimeout)
+		}()
+	}
+
+	wg.Wait()
+
+	var merr *multierror.Error
+	for _, err := range errs {
+		if err != nil {
+			summary.Failed++
+			merr = multierror.Append(merr, err)
+		} else {
+			summary.Deleted++
+		}
+	}
+
+	if err := merr.ErrorOrNil(); err != nil {
+		summary.Status = SweeperStatusFailed
+		summary.Err = fmt.Errorf("sweeping %q: %w", s.name, err)
+		return summary
+	}
+
+	summary.Status = SweeperStatusSwept
+
+	return summary
+}
+
+// describeSweepable returns a human-readable description of the specified Sweepable.
+func describeSweepable(sweepable Sweepable) string {
+	if v, ok := sweepable.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return fmt.Sprintf("%T", sweepable)
+}
+
+// WriteSummaries writes a table of the specified sweeper summaries to w.
+func WriteSummaries(w io.Writer, summaries []*SweeperSummary) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "SWEEPER\tSTATUS\tFOUND\tDELETED\tSKIPPED\tFAILED\tDETAIL")
+	for _, s := range summaries {
+		var detail string
+		switch {
+		case s.Err != nil:
+			detail = s.Err.Error()
+		case s.SkipReason != "":
+			detail = s.SkipReason
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%s\n", s.Name, s.Status, s.Found, s.Deleted, s.Skipped, s.Failed, detail)
+
+		for _, r := range s.Resources {
+			fmt.Fprintf(tw, "  - %s\t\t\t\t\t\t\n", r)
+		}
+	}
+
+	return tw.Flush()
+}
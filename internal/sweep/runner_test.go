@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testSweepable struct {
+	id   string
+	err  error
+	tags map[string]string
+
+	deleted *atomic.Int32
+}
+
+func (s testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.deleted.Add(1)
+
+	return nil
+}
+
+func (s testSweepable) String() string {
+	return s.id
+}
+
+func (s testSweepable) Metadata(context.Context) filter.Metadata {
+	return filter.Metadata{
+		ID:   s.id,
+		Name: s.id,
+		Tags: s.tags,
+	}
+}
+
+// testSweepers records the order in which sweepers are run.
+type testSweepers struct {
+	mu       sync.Mutex
+	ran      []string
+	sweepers map[string]*sweeper
+	deleted  atomic.Int32
+}
+
+func newTestSweepers() *testSweepers {
+	return &testSweepers{
+		sweepers: make(map[string]*sweeper),
+	}
+}
+
+func (ts *testSweepers) register(name string, listErr error, deleteErr error, dependencies ...string) {
+	ts.sweepers[name] = &sweeper{
+		name: name,
+		f: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+			ts.mu.Lock()
+			ts.ran = append(ts.ran, name)
+			ts.mu.Unlock()
+
+			if listErr != nil {
+				return nil, listErr
+			}
+
+			return []Sweepable{
+				testSweepable{id: "tf-acc-test-" + name + "-1", deleted: &ts.deleted, tags: map[string]string{}},
+				testSweepable{id: "tf-acc-test-" + name + "-2", err: deleteErr, deleted: &ts.deleted, tags: map[string]string{"keep": "true"}},
+			}, nil
+		},
+		dependencies: dependencies,
+	}
+}
+
+func (ts *testSweepers) ranBefore(a, b string) bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	i, j := slices.Index(ts.ran, a), slices.Index(ts.ran, b)
+
+	return i >= 0 && j >= 0 && i < j
+}
+
+func summaryStatuses(summaries []*SweeperSummary) map[string]SweeperStatus {
+	statuses := make(map[string]SweeperStatus)
+	for _, s := range summaries {
+		statuses[s.Name] = s.Status
+	}
+	return statuses
+}
+
+func TestRunSweepersDependencyOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := newTestSweepers()
+	ts.register("aws_vpc", nil, nil, "aws_subnet", "aws_internet_gateway")
+	ts.register("aws_subnet", nil, nil, "aws_instance")
+	ts.register("aws_internet_gateway", nil, nil)
+	ts.register("aws_instance", nil, nil)
+
+	summaries, err := runSweepers(ctx, nil, ts.sweepers, nil, WithParallelism(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(summaries), 4; got != expected {
+		t.Fatalf("incorrect number of summaries. Expected: %d, got: %d", expected, got)
+	}
+
+	for _, v := range [][2]string{
+		{"aws_instance", "aws_subnet"},
+		{"aws_subnet", "aws_vpc"},
+		{"aws_internet_gateway", "aws_vpc"},
+	} {
+		if !ts.ranBefore(v[0], v[1]) {
+			t.Errorf("expected %s to run before %s, got %v", v[0], v[1], ts.ran)
+		}
+	}
+
+	if got, expected := summaries[len(summaries)-1].Name, "aws_vpc"; got != expected {
+		t.Errorf("incorrect last sweeper. Expected: %s, got: %s", expected, got)
+	}
+
+	for _, s := range summaries {
+		if s.Status != SweeperStatusSwept || s.Found != 2 || s.Deleted != 2 {
+			t.Errorf("unexpected summary: %+v", s)
+		}
+	}
+}
+
+func TestRunSweepersSelected(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := newTestSweepers()
+	ts.register("aws_vpc", nil, nil, "aws_subnet")
+	ts.register("aws_subnet", nil, nil)
+	ts.register("aws_s3_bucket", nil, nil)
+
+	summaries, err := runSweepers(ctx, nil, ts.sweepers, []string{"aws_vpc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]SweeperStatus{
+		"aws_subnet": SweeperStatusSwept,
+		"aws_vpc":    SweeperStatusSwept,
+	}
+	if diff := cmp.Diff(summaryStatuses(summaries), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	_, err = runSweepers(ctx, nil, ts.sweepers, []string{"aws_example_unregistered"})
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestRunSweepersUnregisteredDependency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := newTestSweepers()
+	ts.register("aws_vpc", nil, nil, "aws_subnet", "aws_example_unregistered")
+	ts.register("aws_subnet", nil, nil)
+
+	_, err := runSweepers(ctx, nil, ts.sweepers, []string{"aws_vpc"})
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := err.Error(), "sweeper (aws_vpc) dependency (aws_example_unregistered) not found"; got != want {
+		t.Errorf("unexpected error. Expected: %s, got: %s", want, got)
+	}
+
+	if len(ts.ran) > 0 {
+		t.Errorf("expected no sweepers to run, got %v", ts.ran)
+	}
+}
+
+func TestRunSweepersFailedPrerequisite(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := newTestSweepers()
+	ts.register("aws_vpc", nil, nil, "aws_subnet", "aws_internet_gateway")
+	ts.register("aws_subnet", nil, nil, "aws_instance")
+	ts.register("aws_internet_gateway", nil, nil)
+	ts.register("aws_instance", nil, errors.New("DependencyViolation"))
+	ts.register("aws_s3_bucket", errors.New("AccessDenied"), nil)
+
+	summaries, err := runSweepers(ctx, nil, ts.sweepers, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]SweeperStatus{
+		"aws_instance":         SweeperStatusFailed,
+		"aws_internet_gateway": SweeperStatusSwept,
+		"aws_s3_bucket":        SweeperStatusFailed,
+		"aws_subnet":           SweeperStatusSkipped,
+		"aws_vpc":              SweeperStatusSkipped,
+	}
+	if diff := cmp.Diff(summaryStatuses(summaries), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	for _, s := range summaries {
+		switch s.Name {
+		case "aws_instance":
+			if s.Found != 2 || s.Deleted != 1 || s.Failed != 1 || s.Err == nil {
+				t.Errorf("unexpected summary: %+v", s)
+			}
+		case "aws_subnet":
+			if got, expected := s.FailedPrerequisite, "aws_instance"; got != expected {
+				t.Errorf("incorrect failed prerequisite. Expected: %s, got: %s", expected, got)
+			}
+		case "aws_vpc":
+			if got, expected := s.FailedPrerequisite, "aws_subnet"; got != expected {
+				t.Errorf("incorrect failed prerequisite. Expected: %s, got: %s", expected, got)
+			}
+		}
+	}
+
+	if slices.Contains(ts.ran, "aws_subnet") || slices.Contains(ts.ran, "aws_vpc") {
+		t.Errorf("expected dependents of failed sweeper not to run, got %v", ts.ran)
+	}
+}
+
+func TestRunSweepersDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := newTestSweepers()
+	ts.register("aws_vpc", nil, nil, "aws_subnet")
+	ts.register("aws_subnet", nil, nil)
+
+	summaries, err := runSweepers(ctx, nil, ts.sweepers, nil, WithDryRun(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := ts.deleted.Load(); got != 0 {
+		t.Errorf("expected no resources to be deleted, got %d", got)
+	}
+
+	want := []*SweeperSummary{
+		{Name: "aws_subnet", Status: SweeperStatusListed, Found: 2, Skipped: 2, Resources: []string{"tf-acc-test-aws_subnet-1", "tf-acc-test-aws_subnet-2"}},
+		{Name: "aws_vpc", Status: SweeperStatusListed, Found: 2, Skipped: 2, Resources: []string{"tf-acc-test-aws_vpc-1", "tf-acc-test-aws_vpc-2"}},
+	}
+	if diff := cmp.Diff(summaries, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	var sb strings.Builder
+	if err := WriteSummaries(&sb, summaries); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(sb.String(), "tf-acc-test-aws_vpc-2") {
+		t.Errorf("expected summary to list resources, got:\n%s", sb.String())
+	}
+}
+
+func TestRunSweepersFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := newTestSweepers()
+	ts.register("aws_vpc", nil, errors.New("DependencyViolation"), "aws_subnet")
+	ts.register("aws_subnet", nil, nil)
+
+	f := &filter.Filter{
+		ExcludeTags: []filter.Tag{{Key: "keep"}},
+	}
+	summaries, err := runSweepers(ctx, nil, ts.sweepers, nil, WithFilter(f))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The resources that fail to delete are excluded by the filter.
+	for _, s := range summaries {
+		if s.Status != SweeperStatusSwept || s.Found != 2 || s.Deleted != 1 || s.Skipped != 1 || s.Failed != 0 {
+			t.Errorf("unexpected summary: %+v", s)
+		}
+	}
+
+	if got, expected := ts.deleted.Load(), int32(2); got != expected {
+		t.Errorf("incorrect number of deleted resources. Expected: %d, got: %d", expected, got)
+	}
+}
+
+func TestRunSweepersFilterUnknownMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var deleted atomic.Int32
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		DeleteWithoutTimeout: func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+			deleted.Add(1)
+			return nil
+		},
+	}
+
+	for name, f := range map[string]*filter.Filter{
+		"exclude tags": {ExcludeTags: []filter.Tag{{Key: "keep"}}},
+		"min age":      {MinAge: time.Hour},
+	} {
+		sweepers := map[string]*sweeper{
+			"aws_example": {
+				name: "aws_example",
+				f: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+					// Only the ID is set, so the resource's tags and creation time are unknown.
+					d := r.Data(nil)
+					d.SetId("tf-acc-test-1")
+
+					return []Sweepable{NewSweepResource(r, d, nil)}, nil
+				},
+			},
+		}
+
+		summaries, err := runSweepers(ctx, nil, sweepers, nil, WithFilter(f))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+
+		if s := summaries[0]; s.Status != SweeperStatusSwept || s.Found != 1 || s.Deleted != 0 || s.Skipped != 1 {
+			t.Errorf("%s: unexpected summary: %+v", name, s)
+		}
+	}
+
+	if got := deleted.Load(); got != 0 {
+		t.Errorf("expected no resources to be deleted, got %d", got)
+	}
+}
+
+func TestFilterSweepablesReport(t *testing.T) { //nolint:paralleltest // Sets the package-level report writer.
+	var sb strings.Builder
+	SetReportWriter(&sb)
+	t.Cleanup(func() {
+		report = nil
+	})
+
+	ctx := withResourceType(context.WithValue(context.Background(), contextKeyRegion, "us-west-2"), "aws_vpc")
+	sweepables := []Sweepable{
+		testSweepable{id: "tf-acc-test-1"},
+		testSweepable{id: "production", tags: map[string]string{"Owner": "platform"}},
+	}
+	f := &filter.Filter{
+		NamePrefixes: []string{"tf-acc-test-"},
+	}
+
+	if got, expected := len(filterSweepables(ctx, sweepables, f, true)), 1; got != expected {
+		t.Fatalf("incorrect number of sweepables. Expected: %d, got: %d", expected, got)
+	}
+
+	var got []ReportEntry
+	for _, line := range strings.Split(strings.TrimSpace(sb.String()), "\n") {
+		var entry ReportEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got = append(got, entry)
+	}
+
+	want := []ReportEntry{
+		{Region: "us-west-2", ResourceType: "aws_vpc", ID: "tf-acc-test-1", Name: "tf-acc-test-1", Action: ReportActionDelete, DryRun: true},
+		{Region: "us-west-2", ResourceType: "aws_vpc", ID: "production", Name: "production", Tags: map[string]string{"Owner": "platform"}, Action: ReportActionSkip, Reason: "name (production) has no allowed prefix", DryRun: true},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRunSweepersParallelism(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sweepers := make(map[string]*sweeper)
+	var running, maxRunning atomic.Int32
+
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		sweepers[name] = &sweeper{
+			name: name,
+			f: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+				n := running.Add(1)
+				defer running.Add(-1)
+
+				for {
+					v := maxRunning.Load()
+					if n <= v || maxRunning.CompareAndSwap(v, n) {
+						break
+					}
+				}
+
+				time.Sleep(20 * time.Millisecond)
+
+				return nil, nil
+			},
+		}
+	}
+
+	if _, err := runSweepers(ctx, nil, sweepers, nil, WithParallelism(2)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := maxRunning.Load(); got > 2 {
+		t.Errorf("expected at most 2 concurrent sweepers, got %d", got)
+	}
+}
+
+func TestRunSweepersCycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := newTestSweepers()
+	ts.register("a", nil, nil, "b")
+	ts.register("b", nil, nil, "a")
+
+	if _, err := runSweepers(ctx, nil, ts.sweepers, nil); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
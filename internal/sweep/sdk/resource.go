@@ -17,7 +17,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +41,53 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
+// Metadata describes the resource for filtering using any name, tags and creation time set in its data.
+func (sr *sweepResource) Metadata(context.Context) filter.Metadata {
+	m := filter.Metadata{
+		ID: sr.d.Id(),
+	}
+	s := sr.resource.SchemaMap()
+
+	if _, ok := s[names.AttrName]; ok {
+		if v, ok := sr.d.Get(names.AttrName).(string); ok {
+			m.Name = v
+		}
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := s[k]; !ok {
+			continue
+		}
+
+		// Tags are unknown unless they have been set, e.g. by the sweeper or by reading the resource.
+		if v, ok := sr.d.GetOkExists(k); ok {
+			v := v.(map[string]any)
+			m.Tags = make(map[string]string, len(v))
+			for key, value := range v {
+				m.Tags[key], _ = value.(string)
+			}
+			break
+		}
+	}
+
+	for _, k := range filter.CreationTimeAttributes {
+		if v, ok := s[k]; !ok || v.Type != schema.TypeString {
+			continue
+		}
+
+		if t, ok := filter.ParseCreationTime(sr.d.Get(k).(string)); ok {
+			m.CreatedAt = t
+			break
+		}
+	}
+
+	return m
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
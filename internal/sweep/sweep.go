@@ -11,6 +11,7 @@ import (
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -69,24 +70,27 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		var assumeRole awsbase.AssumeRole
+		assumeRole.RoleARN = role
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -105,11 +109,22 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources that DeletionFilter allows to be deleted.
+// No resources are deleted if DryRun is set.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	sweepables = filterSweepables(ctx, sweepables, DeletionFilter, DryRun)
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	if DryRun {
+		tflog.Info(ctx, "Dry run, not sweeping resources", map[string]any{
+			"count": len(sweepables),
+		})
+		return nil
+	}
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
@@ -139,11 +154,17 @@ func PartitionDNSSuffix(region string) string {
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
 func Register(name string, f SweeperFn, dependencies ...string) {
+	registeredSweepers[name] = &sweeper{
+		name:         name,
+		f:            f,
+		dependencies: dependencies,
+	}
+
 	resource.AddTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
 			ctx := Context(region)
-			ctx = logWithResourceType(ctx, name)
+			ctx = withResourceType(ctx, name)
 
 			client, err := SharedRegionalSweepClient(ctx, region)
 			if err != nil {
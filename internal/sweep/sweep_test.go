@@ -5,10 +5,26 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+var (
+	flagSweepGraph        = flag.Bool("sweep-graph", false, "Enable to run Sweepers in dependency order, skipping those whose prerequisites fail")
+	flagSweepDryRun       = flag.Bool("sweep-dry-run", false, "Enable to report the resources that Sweepers would delete without deleting them")
+	flagSweepParallelism  = flag.Int("sweep-parallelism", 4, "Maximum number of Sweepers run concurrently with -sweep-graph")
+	flagSweepNamePrefixes = flag.String("sweep-name-prefixes", "", "Comma separated list of name prefixes, e.g. tf-acc-test-, of resources that may be deleted")
+	flagSweepIncludeTags  = flag.String("sweep-include-tags", "", "Comma separated list of tags (key or key=value) of which resources must have one to be deleted")
+	flagSweepExcludeTags  = flag.String("sweep-exclude-tags", "", "Comma separated list of tags (key or key=value) that prevent deletion of resources")
+	flagSweepMinAge       = flag.Duration("sweep-min-age", 0, "Minimum age of resources that may be deleted, where the creation time is known")
+	flagSweepReport       = flag.String("sweep-report", "", "File to write a JSON report of the resources found by Sweepers to (default standard output with -sweep-dry-run)")
 )
 
 func TestMain(m *testing.M) {
@@ -18,5 +34,108 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+
+	if err := configureSweep(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if *flagSweepGraph {
+		os.Exit(runSweepGraph())
+	}
+
 	resource.TestMain(m)
 }
+
+// configureSweep configures resource filtering, dry-run mode and reporting from the command line flags.
+func configureSweep() error {
+	var f filter.Filter
+	var err error
+
+	f.NamePrefixes = splitList(*flagSweepNamePrefixes)
+	if f.IncludeTags, err = filter.ParseTags(*flagSweepIncludeTags); err != nil {
+		return fmt.Errorf("-sweep-include-tags: %w", err)
+	}
+	if f.ExcludeTags, err = filter.ParseTags(*flagSweepExcludeTags); err != nil {
+		return fmt.Errorf("-sweep-exclude-tags: %w", err)
+	}
+	f.MinAge = *flagSweepMinAge
+
+	if !f.IsEmpty() {
+		sweep.DeletionFilter = &f
+	}
+	sweep.DryRun = *flagSweepDryRun
+
+	if v := *flagSweepReport; v != "" {
+		file, err := os.Create(v)
+		if err != nil {
+			return fmt.Errorf("-sweep-report: %w", err)
+		}
+		// The file is closed when the process exits.
+		sweep.SetReportWriter(file)
+	} else if sweep.DryRun {
+		sweep.SetReportWriter(os.Stdout)
+	}
+
+	return nil
+}
+
+// runSweepGraph runs the Sweepers registered via sweep.Register in dependency order in each Region specified by -sweep.
+// Sweepers can be selected with -sweep-run.
+func runSweepGraph() int {
+	regions := splitList(lookupFlagValue("sweep"))
+	if len(regions) == 0 {
+		fmt.Fprintln(os.Stderr, "-sweep is required with -sweep-graph")
+		return 2
+	}
+	names := splitList(lookupFlagValue("sweep-run"))
+
+	exitCode := 0
+	for _, region := range regions {
+		ctx := sweep.Context(region)
+
+		summaries, err := sweep.RunSweepers(ctx, region, names, sweep.WithParallelism(*flagSweepParallelism))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "running sweepers (%s): %s\n", region, err)
+			exitCode = 1
+			continue
+		}
+
+		fmt.Printf("Sweeper summary (%s):\n", region)
+		if err := sweep.WriteSummaries(os.Stdout, summaries); err != nil {
+			fmt.Fprintf(os.Stderr, "writing sweeper summary (%s): %s\n", region, err)
+			exitCode = 1
+		}
+
+		for _, summary := range summaries {
+			if summary.Status == sweep.SweeperStatusFailed {
+				exitCode = 1
+			}
+		}
+	}
+
+	return exitCode
+}
+
+// lookupFlagValue returns the value of the named flag.
+// The -sweep and -sweep-run flags are defined by the terraform-plugin-testing module.
+func lookupFlagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// splitList returns the non-empty values of the specified comma-separated list.
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	PolicySeverityError   = "error"
+	PolicySeverityWarning = "warning"
+)
+
+// PolicyConfig contains a tag policy to enforce across all taggable resources.
+type PolicyConfig struct {
+	// OrganizationsPolicy is whether the account's effective AWS Organizations tag policy is also enforced.
+	OrganizationsPolicy bool
+	// RequiredKeys are the tag keys that every taggable resource must have.
+	RequiredKeys []string
+	// Rules constrain the values of individual tag keys.
+	Rules []PolicyRule
+	// Severity is the severity with which violations are reported, either PolicySeverityError or PolicySeverityWarning.
+	Severity string
+}
+
+// PolicyRule constrains the value of a single tag key.
+// A tag whose value matches any of AllowedValues or ValuePatterns complies with the rule.
+// If EnforceKeyCase is set the rule also applies to tags whose keys differ from Key only in case,
+// and such tags are themselves violations.
+type PolicyRule struct {
+	Key            string
+	AllowedValues  []string
+	ValuePatterns  []*regexp.Regexp
+	EnforceKeyCase bool
+}
+
+// IsWarning returns whether violations of the tag policy are reported as warnings.
+func (pc *PolicyConfig) IsWarning() bool {
+	return pc != nil && pc.Severity == PolicySeverityWarning
+}
+
+// Violations returns a description of each way in which the specified tags violate the tag policy.
+// Tags with a nil value, e.g. those whose value is not yet known, satisfy any required key but are not otherwise checked.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	var missing []string
+	for _, k := range pc.RequiredKeys {
+		if _, ok := tags[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		violations = append(violations, fmt.Sprintf("missing required tag keys: %s", strings.Join(missing, ", ")))
+	}
+
+	for _, rule := range pc.Rules {
+		for _, k := range rule.keys(tags) {
+			if k != rule.Key {
+				violations = append(violations, fmt.Sprintf("tag %q must have key %q", k, rule.Key))
+			}
+
+			v := tags[k]
+			if v == nil || v.Value == nil {
+				continue
+			}
+
+			if value := *v.Value; !rule.allows(value) {
+				violations = append(violations, fmt.Sprintf("tag %q has value %q, %s", k, value, rule.String()))
+			}
+		}
+	}
+
+	return violations
+}
+
+// WithRules returns a copy of the tag policy with the specified additional rules.
+func (pc *PolicyConfig) WithRules(rules []PolicyRule) *PolicyConfig {
+	if pc == nil {
+		return nil
+	}
+
+	pc2 := *pc
+	pc2.Rules = make([]PolicyRule, 0, len(pc.Rules)+len(rules))
+	pc2.Rules = append(pc2.Rules, pc.Rules...)
+	pc2.Rules = append(pc2.Rules, rules...)
+
+	return &pc2
+}
+
+// OrganizationsPolicyRules returns the rules in the specified AWS Organizations effective tag policy document.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+// A tag policy's compliance enforcement settings (enforced_for) are not used; all violations are reported.
+func OrganizationsPolicyRules(document string) ([]PolicyRule, error) {
+	var policy struct {
+		Tags map[string]struct {
+			TagKey   string   `json:"tag_key"`
+			TagValue []string `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	keys := make([]string, 0, len(policy.Tags))
+	for k := range policy.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rules := make([]PolicyRule, 0, len(keys))
+	for _, k := range keys {
+		v := policy.Tags[k]
+
+		rule := PolicyRule{
+			Key:            v.TagKey,
+			EnforceKeyCase: true,
+		}
+		if rule.Key == "" {
+			rule.Key = k
+		}
+
+		for _, v := range v.TagValue {
+			// The only wildcard supported in tag policy values is '*', matching any sequence of characters.
+			if !strings.Contains(v, "*") {
+				rule.AllowedValues = append(rule.AllowedValues, v)
+				continue
+			}
+
+			parts := strings.Split(v, "*")
+			for i, part := range parts {
+				parts[i] = regexp.QuoteMeta(part)
+			}
+			re, err := regexp.Compile("^" + strings.Join(parts, ".*") + "$")
+			if err != nil {
+				return nil, fmt.Errorf("parsing AWS Organizations tag policy (%s) value (%s): %w", k, v, err)
+			}
+			rule.ValuePatterns = append(rule.ValuePatterns, re)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// keys returns the keys of the specified tags to which the rule applies.
+func (r PolicyRule) keys(tags KeyValueTags) []string {
+	if !r.EnforceKeyCase {
+		if _, ok := tags[r.Key]; ok {
+			return []string{r.Key}
+		}
+
+		return nil
+	}
+
+	var keys []string
+	for k := range tags {
+		if strings.EqualFold(k, r.Key) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (r PolicyRule) allows(value string) bool {
+	if slices.Contains(r.AllowedValues, value) {
+		return true
+	}
+
+	for _, re := range r.ValuePatterns {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+
+	return len(r.AllowedValues) == 0 && len(r.ValuePatterns) == 0
+}
+
+// String returns a description of the values allowed by the rule.
+func (r PolicyRule) String() string {
+	var allowed []string
+
+	if len(r.AllowedValues) > 0 {
+		values := make([]string, len(r.AllowedValues))
+		for i, v := range r.AllowedValues {
+			values[i] = fmt.Sprintf("%q", v)
+		}
+		allowed = append(allowed, fmt.Sprintf("one of %s", strings.Join(values, ", ")))
+	}
+
+	for _, re := range r.ValuePatterns {
+		allowed = append(allowed, fmt.Sprintf("a value matching %q", re.String()))
+	}
+
+	return fmt.Sprintf("must be %s", strings.Join(allowed, " or "))
+}
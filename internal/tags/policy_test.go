@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &PolicyConfig{
+		RequiredKeys: []string{"Owner", "CostCenter"},
+		Rules: []PolicyRule{
+			{
+				Key:           "Environment",
+				AllowedValues: []string{"dev", "prod"},
+			},
+			{
+				Key:           "CostCenter",
+				ValuePatterns: []*regexp.Regexp{regexache.MustCompile(`^CC-\d{4}$`)},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		policy *PolicyConfig
+		tags   KeyValueTags
+		want   []string
+	}{
+		{
+			name:   "nil policy",
+			policy: nil,
+			tags:   New(ctx, map[string]string{}),
+		},
+		{
+			name:   "compliant",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "CC-1234",
+				"Environment": "prod",
+				"Owner":       "team-a",
+			}),
+		},
+		{
+			name:   "compliant no constrained key",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "CC-1234",
+				"Owner":      "team-a",
+			}),
+		},
+		{
+			name:   "missing required keys",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"Environment": "dev",
+			}),
+			want: []string{
+				"missing required tag keys: CostCenter, Owner",
+			},
+		},
+		{
+			name:   "disallowed values",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "staging",
+				"Owner":       "team-a",
+			}),
+			want: []string{
+				`tag "Environment" has value "staging", must be one of "dev", "prod"`,
+				`tag "CostCenter" has value "1234", must be a value matching "^CC-\\d{4}$"`,
+			},
+		},
+		{
+			name:   "unknown values",
+			policy: policy,
+			tags: New(ctx, map[string]*string{
+				"CostCenter":  nil,
+				"Environment": nil,
+				"Owner":       nil,
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.Violations(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationsPolicyRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	document := `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200", "300*"],
+      "enforced_for": ["secretsmanager:*"]
+    },
+    "project": {
+      "tag_key": "Project"
+    }
+  }
+}`
+
+	rules, err := OrganizationsPolicyRules(document)
+	if err != nil {
+		t.Fatalf("OrganizationsPolicyRules: %s", err)
+	}
+
+	policy := (&PolicyConfig{}).WithRules(rules)
+
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []string
+	}{
+		{
+			name: "compliant",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "3001",
+				"Other":      "value",
+				"Project":    "alpha",
+			}),
+		},
+		{
+			name: "disallowed value",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "400",
+			}),
+			want: []string{
+				`tag "CostCenter" has value "400", must be one of "100", "200" or a value matching "^300.*$"`,
+			},
+		},
+		{
+			name: "incorrect key case",
+			tags: New(ctx, map[string]string{
+				"costcenter": "100",
+				"PROJECT":    "alpha",
+			}),
+			want: []string{
+				`tag "costcenter" must have key "CostCenter"`,
+				`tag "PROJECT" must have key "Project"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policy.Violations(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationsPolicyRules_invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := OrganizationsPolicyRules(`{"tags": []}`); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/datasources"
+	"github.com/spf13/cobra"
+)
+
+var datasourcesCmd = &cobra.Command{
+	Use:   "datasources",
+	Short: "Create scaffolding for a plural data source with filters",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return datasources.Create(name, snakeName, itemName, !clearComments, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(datasourcesCmd)
+	datasourcesCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "if skaff doesn't get it right, explicitly give name in snake case (e.g., db_instances)")
+	datasourcesCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	datasourcesCmd.Flags().StringVarP(&name, "name", "n", "", "plural name of the entity (e.g., DBInstances)")
+	datasourcesCmd.Flags().StringVarP(&itemName, "item-name", "i", "", "if skaff doesn't get it right, explicitly give the singular name of the listed entity (e.g., DBInstance)")
+	datasourcesCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+}
@@ -16,6 +16,7 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	itemName      string
 )
 
 var resourceCmd = &cobra.Command{
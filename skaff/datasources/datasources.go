@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
+)
+
+//go:embed datasourcesfw.tmpl
+var datasourcesFrameworkTmpl string
+
+//go:embed datasourcestest.tmpl
+var datasourcesTestTmpl string
+
+//go:embed websitedoc.tmpl
+var websiteTmpl string
+
+type TemplateData struct {
+	DataSource           string
+	DataSourceLower      string
+	DataSourceSnake      string
+	Item                 string
+	ItemSnake            string
+	IncludeComments      bool
+	HumanFriendlyService string
+	ServicePackage       string
+	Service              string
+	ServiceLower         string
+	AWSServiceName       string
+	FiltersFunc          string
+	HumanDataSourceName  string
+	ProviderResourceName string
+}
+
+// Singular returns a best guess at the singular form of a plural, capitalized name
+// (e.g., Repositories -> Repository).
+func Singular(plural string) string {
+	switch {
+	case strings.HasSuffix(plural, "ies"):
+		return strings.TrimSuffix(plural, "ies") + "y"
+	case strings.HasSuffix(plural, "sses"), strings.HasSuffix(plural, "shes"), strings.HasSuffix(plural, "ches"), strings.HasSuffix(plural, "xes"):
+		return strings.TrimSuffix(plural, "es")
+	case strings.HasSuffix(plural, "ss"):
+		return plural
+	case strings.HasSuffix(plural, "s"):
+		return strings.TrimSuffix(plural, "s")
+	default:
+		return plural
+	}
+}
+
+func Create(dsName, snakeName, itemName string, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if dsName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if dsName == strings.ToLower(dsName) {
+		return fmt.Errorf("error checking: name should be properly capitalized and plural (e.g., DBInstances)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instances)")
+	}
+
+	if itemName == "" {
+		itemName = Singular(dsName)
+	}
+
+	if itemName == strings.ToLower(itemName) {
+		return fmt.Errorf("error checking: item name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	snakeName = resource.ToSnakeCase(dsName, snakeName)
+
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	sn, err := names.FullHumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service name: %w", err)
+	}
+
+	hf, err := names.HumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting human-friendly name: %w", err)
+	}
+
+	templateData := TemplateData{
+		DataSource:           dsName,
+		DataSourceLower:      strings.ToLower(dsName),
+		DataSourceSnake:      snakeName,
+		Item:                 itemName,
+		ItemSnake:            resource.ToSnakeCase(itemName, ""),
+		HumanFriendlyService: hf,
+		IncludeComments:      comments,
+		ServicePackage:       servicePackage,
+		Service:              s,
+		ServiceLower:         strings.ToLower(s),
+		AWSServiceName:       sn,
+		// Matches the functions generated by internal/generate/namevaluesfiltersv2/generators/servicefilters.
+		FiltersFunc:          strings.ToUpper(servicePackage[:1]) + servicePackage[1:] + "Filters",
+		HumanDataSourceName:  resource.HumanResName(dsName),
+		ProviderResourceName: resource.ProviderResourceName(servicePackage, snakeName),
+	}
+
+	f := fmt.Sprintf("%s_data_source.go", snakeName)
+	if err = writeTemplate("newds", f, datasourcesFrameworkTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing datasources template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_data_source_test.go", snakeName)
+	if err = writeTemplate("dstest", tf, datasourcesTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing datasources test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing datasources website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if _, err := f.Write(buffer.Bytes()); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
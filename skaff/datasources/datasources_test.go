@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+import (
+	"testing"
+)
+
+func TestSingular(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			TestName: "simple",
+			Input:    "Cheeses",
+			Expected: "Cheese",
+		},
+		{
+			TestName: "ies",
+			Input:    "Repositories",
+			Expected: "Repository",
+		},
+		{
+			TestName: "sses",
+			Input:    "Addresses",
+			Expected: "Address",
+		},
+		{
+			TestName: "ches",
+			Input:    "Branches",
+			Expected: "Branch",
+		},
+		{
+			TestName: "initialism",
+			Input:    "DBInstances",
+			Expected: "DBInstance",
+		},
+		{
+			TestName: "not plural",
+			Input:    "Access",
+			Expected: "Access",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := Singular(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -11,24 +11,30 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
 
 var (
-	dataSourceType = flag.String("data-source", "", "Data Source type")
-	resourceType   = flag.String("resource", "", "Resource type")
+	dataSourceType  = flag.String("data-source", "", "Data Source type")
+	providerVersion = flag.String("provider-version", "", "Version of the provider whose Plugin SDK v2 resource state the generated test migrates from")
+	resourceType    = flag.String("resource", "", "Resource type")
+	testFilename    = flag.String("test", "", "Generated state-compatibility test file (resources only)")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [-test <generated-test-file>] [-provider-version <version>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -37,7 +43,7 @@ func main() {
 
 	args := flag.Args()
 
-	if len(args) < 3 || (*dataSourceType == "" && *resourceType == "") {
+	if len(args) < 3 || (*dataSourceType == "" && *resourceType == "") || (*dataSourceType != "" && *testFilename != "") {
 		flag.Usage()
 		os.Exit(2)
 	}
@@ -53,9 +59,10 @@ func main() {
 	// }
 	g := common.NewGenerator()
 	migrator := &migrator{
-		Generator:   g,
-		Name:        name,
-		PackageName: packageName,
+		Generator:       g,
+		Name:            name,
+		PackageName:     packageName,
+		ProviderVersion: *providerVersion,
 	}
 
 	p, err := provider.New(context.Background())
@@ -84,25 +91,34 @@ func main() {
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = resourceTestImpl
 		migrator.TFTypeName = v
 	}
 
 	if err := migrator.migrate(outputFilename); err != nil {
 		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
 	}
+
+	if v := *testFilename; v != "" {
+		if err := migrator.migrateTest(v); err != nil {
+			g.Fatalf("error generating Terraform %s state-compatibility test: %s", *resourceType, err)
+		}
+	}
 }
 
 type migrator struct {
-	Generator    *common.Generator
-	IsDataSource bool
-	Name         string
-	PackageName  string
-	Resource     *schema.Resource
-	Template     string
-	TFTypeName   string
+	Generator       *common.Generator
+	IsDataSource    bool
+	Name            string
+	PackageName     string
+	ProviderVersion string
+	Resource        *schema.Resource
+	Template        string
+	TestTemplate    string
+	TFTypeName      string
 }
 
-// migrate generates an identical schema into the specified output file.
+// migrate generates an identical schema, and a resource or data source skeleton, into the specified output file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -129,14 +145,43 @@ func (m *migrator) migrate(outputFilename string) error {
 	return d.Write()
 }
 
+// migrateTest generates an acceptance test into the specified output file.
+// The test creates a resource using the last provider version that implemented the resource with Plugin SDK v2
+// and then verifies that the migrated resource plans no changes from the resulting state.
+func (m *migrator) migrateTest(outputFilename string) error {
+	m.infof("generating test into %[1]q", outputFilename)
+
+	templateData, err := m.generateTemplateData()
+
+	if err != nil {
+		return err
+	}
+
+	if templateData.ProviderVersion == "" {
+		templateData.ProviderVersion = "TODO"
+		m.Generator.Warnf("No -provider-version specified")
+	}
+
+	d := m.Generator.NewGoFileDestination(outputFilename)
+
+	if err := d.WriteTemplate("test", m.TestTemplate, templateData); err != nil {
+		return err
+	}
+
+	return d.Write()
+}
+
 func (m *migrator) generateTemplateData() (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
+	sbNestedStructs := strings.Builder{}
 	emitter := &emitter{
-		Generator:    m.Generator,
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
+		Generator:          m.Generator,
+		IsDataSource:       m.IsDataSource,
+		ModelNames:         make(map[string]struct{}),
+		NestedStructWriter: &sbNestedStructs,
+		SchemaWriter:       &sbSchema,
+		StructWriter:       &sbStruct,
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -145,22 +190,56 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	resource := m.Resource
+	hasTags := emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		CreateFunc:                   funcName(resource.CreateWithoutTimeout, resource.CreateContext, resource.Create),
+		DefaultCreateTimeout:         goDuration(emitter.DefaultCreateTimeout),
+		DefaultReadTimeout:           goDuration(emitter.DefaultReadTimeout),
+		DefaultUpdateTimeout:         goDuration(emitter.DefaultUpdateTimeout),
+		DefaultDeleteTimeout:         goDuration(emitter.DefaultDeleteTimeout),
+		DeleteFunc:                   funcName(resource.DeleteWithoutTimeout, resource.DeleteContext, resource.Delete),
+		EmitResourceImportState:      resource.Importer != nil,
+		EmitResourceModifyPlan:       !m.IsDataSource && (hasTags || resource.CustomizeDiff != nil),
+		EmitResourceUpdateSkeleton:   resource.Update != nil || resource.UpdateContext != nil || resource.UpdateWithoutTimeout != nil,
+		HasCustomizeDiff:             resource.CustomizeDiff != nil,
+		HasTags:                      hasTags,
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
 		Name:                         m.Name,
+		NestedStructs:                sbNestedStructs.String(),
 		PackageName:                  m.PackageName,
+		ProviderVersion:              m.ProviderVersion,
+		ReadFunc:                     funcName(resource.ReadWithoutTimeout, resource.ReadContext, resource.Read),
 		Schema:                       sbSchema.String(),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
+		UpdateFunc:                   funcName(resource.UpdateWithoutTimeout, resource.UpdateContext, resource.Update),
+	}
+
+	for _, v := range resource.StateUpgraders {
+		templateData.StateUpgraderVersions = append(templateData.StateUpgraderVersions, v.Version)
+	}
+
+	if v, err := names.ProviderNameUpper(m.PackageName); err != nil {
+		m.Generator.Warnf("%s", err)
+		templateData.ProviderNameUpper = "TODO"
+	} else {
+		templateData.ProviderNameUpper = v
+	}
+
+	if v, err := names.HumanFriendly(m.PackageName); err != nil {
+		m.Generator.Warnf("%s", err)
+		templateData.HumanFriendly = "TODO"
+	} else {
+		templateData.HumanFriendly = v
+	}
+
+	if v, err := names.AWSGoV2Package(m.PackageName); err != nil || v == "" {
+		templateData.AWSGoV2Package = m.PackageName
+	} else {
+		templateData.AWSGoV2Package = v
 	}
 
 	for _, v := range emitter.FrameworkPlanModifierPackages {
@@ -200,6 +279,8 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	ModelNames                    map[string]struct{} // Names of the model structs emitted for nested blocks.
+	NestedStructWriter            io.Writer
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
@@ -261,10 +342,9 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 // emitAttributesAndBlocks generates the Plugin Framework code for a set of Plugin SDK Attributes and Blocks
 // and emits the generated code to the emitter's Writer.
+// A model struct field is emitted for each Attribute and Block.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
 	// At this point we are emitting code for a schema.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -286,10 +366,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -297,9 +374,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -321,6 +396,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -328,6 +404,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -354,9 +431,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +439,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +447,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,9 +460,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -399,9 +468,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -411,17 +478,15 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	// Complex types.
 	//
 	case schema.TypeList, schema.TypeMap, schema.TypeSet:
-		var aggregateSchemaFactory, typeName string
+		var aggregateSchemaFactory, customType, structType, typeName string
 
 		switch v {
 		case schema.TypeList:
 			aggregateSchemaFactory = "schema.ListAttribute{"
+			customType = "fwtypes.ListOfStringType"
+			structType = "List"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -429,12 +494,10 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		case schema.TypeMap:
 			aggregateSchemaFactory = "schema.MapAttribute{"
+			customType = "fwtypes.MapOfStringType"
+			structType = "Map"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -442,12 +505,10 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		case schema.TypeSet:
 			aggregateSchemaFactory = "schema.SetAttribute{"
+			customType = "fwtypes.SetOfStringType"
+			structType = "Set"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
@@ -490,10 +551,22 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+
+			// Collections of strings use the provider's typed collections so that they are ready for AutoFlEx.
+			if elementType == "types.StringType" {
+				e.ImportProviderFrameworkTypes = true
+
+				fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
+				fprintf(e.StructWriter, "fwtypes.%sValueOf[types.String]", structType)
+			} else {
+				fprintf(e.StructWriter, "types.%s", structType)
+			}
+
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
+			fprintf(e.StructWriter, "types.%s", structType)
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
 			fprintf(e.SchemaWriter, "ElementType:")
 
@@ -614,10 +687,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := e.modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedBlockObject(path, modelName, v.Schema)
 
 			if err != nil {
 				return err
@@ -637,10 +715,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := e.modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedBlockObject(path, modelName, v.Schema)
 
 			if err != nil {
 				return err
@@ -712,6 +795,44 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitNestedBlockObject generates the Plugin Framework code for a Plugin SDK Block's nested object
+// and emits the generated code to the emitter's Writer.
+// The nested object's model struct is emitted to the emitter's NestedStructWriter.
+func (e *emitter) emitNestedBlockObject(path []string, modelName string, schema map[string]*schema.Schema) error {
+	structWriter := e.StructWriter
+	sbStruct := strings.Builder{}
+	e.StructWriter = &sbStruct
+
+	err := e.emitAttributesAndBlocks(path, schema)
+
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
+	}
+
+	fprintf(e.NestedStructWriter, "type %s struct {\n%s}\n\n", modelName, sbStruct.String())
+
+	return nil
+}
+
+// modelName returns a unique name for the model struct of the Plugin SDK Block at the specified path.
+func (e *emitter) modelName(path []string) string {
+	name := naming.ToCamelCase(path[len(path)-1])
+
+	if _, ok := e.ModelNames[name]; ok {
+		// Disambiguate using the full path.
+		names := make([]string, len(path))
+		for i, v := range path {
+			names[i] = naming.ToCamelCase(v)
+		}
+		name = strings.Join(names, "")
+	}
+	e.ModelNames[name] = struct{}{}
+
+	return strings.ToLower(name[:1]) + name[1:] + "Model"
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -845,6 +966,51 @@ func fprintf(w io.Writer, format string, a ...interface{}) (int, error) {
 	return io.WriteString(w, fmt.Sprintf(format, a...))
 }
 
+// funcName returns the unqualified name of the first non-nil function, e.g. "resourceVPCCreate".
+func funcName(fs ...any) string {
+	for _, f := range fs {
+		v := reflect.ValueOf(f)
+
+		if v.Kind() != reflect.Func || v.IsNil() {
+			continue
+		}
+
+		name := runtime.FuncForPC(v.Pointer()).Name()
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+		if _, after, ok := strings.Cut(name, "."); ok {
+			name = after
+		}
+
+		return name
+	}
+
+	return ""
+}
+
+// goDuration returns Go code for the specified duration, e.g. "20 * time.Minute".
+func goDuration(d int64) string {
+	if d <= 0 {
+		return ""
+	}
+
+	for _, v := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+	} {
+		if d%int64(v.unit) == 0 {
+			return fmt.Sprintf("%d * %s", d/int64(v.unit), v.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
 // isAttribute returns whether or not the specified property should be emitted as an Attribute (vs. a Block).
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/helper/schema/core_schema.go#L57.
 func isAttribute(property *schema.Schema) bool {
@@ -883,24 +1049,36 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	AWSGoV2Package                string // e.g. ec2
+	CreateFunc                    string // e.g. resourceInstanceCreate
+	DefaultCreateTimeout          string // e.g. 10 * time.Minute
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
+	DeleteFunc                    string
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
+	HasCustomizeDiff              bool
+	HasTags                       bool
 	HasTimeouts                   bool
+	HumanFriendly                 string // e.g. EC2 (Elastic Compute Cloud)
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	Name                          string // e.g. Instance
+	NestedStructs                 string
 	PackageName                   string // e.g. ec2
+	ProviderNameUpper             string // e.g. EC2
 	ProviderPlanModifierPackages  []string
+	ProviderVersion               string // e.g. 5.43.0
+	ReadFunc                      string
 	Schema                        string
+	StateUpgraderVersions         []int
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	UpdateFunc                    string
 }
 
 //go:embed datasource.tmpl
@@ -908,3 +1086,6 @@ var datasourceImpl string
 
 //go:embed resource.tmpl
 var resourceImpl string
+
+//go:embed resource_test.tmpl
+var resourceTestImpl string